@@ -2,11 +2,17 @@ package signers
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math/big"
+	"os"
 
 	"github.com/everFinance/goar"
 	"github.com/everFinance/goar/types"
+	"github.com/everFinance/goar/utils"
 	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
 )
 
@@ -16,6 +22,10 @@ type ArweaveSigner struct {
 	itemSigner *goar.ItemSigner
 }
 
+// DefaultArweaveKeyBits is the RSA modulus size of Arweave wallets. ANS-104 data items
+// require 4096-bit keys.
+const DefaultArweaveKeyBits = 4096
+
 // NewArweaveSigner creates a new Arweave signer from a JWK
 func NewArweaveSigner(jwk map[string]interface{}) (*ArweaveSigner, error) {
 	// Convert JWK map to JSON bytes
@@ -24,20 +34,27 @@ func NewArweaveSigner(jwk map[string]interface{}) (*ArweaveSigner, error) {
 		return nil, fmt.Errorf("failed to marshal JWK: %w", err)
 	}
 
-	signer, err := goar.NewSigner(jwkBytes)
+	return NewArweaveSignerFromJSON(jwkBytes)
+}
+
+// NewArweaveSignerFromJSON creates a new Arweave signer from a JSON-encoded JWK
+func NewArweaveSignerFromJSON(jwk []byte) (*ArweaveSigner, error) {
+	signer, err := goar.NewSigner(jwk)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create signer from JWK: %w", err)
 	}
 
-	itemSigner, err := goar.NewItemSigner(signer)
+	return newArweaveSigner(signer)
+}
+
+// NewArweaveSignerFromReader creates a new Arweave signer from a JSON-encoded JWK read from r
+func NewArweaveSignerFromReader(r io.Reader) (*ArweaveSigner, error) {
+	jwk, err := io.ReadAll(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create item signer: %w", err)
+		return nil, fmt.Errorf("failed to read JWK: %w", err)
 	}
 
-	return &ArweaveSigner{
-		signer:     signer,
-		itemSigner: itemSigner,
-	}, nil
+	return NewArweaveSignerFromJSON(jwk)
 }
 
 // NewArweaveSignerFromKeyfile creates a new Arweave signer from a keyfile path
@@ -47,6 +64,51 @@ func NewArweaveSignerFromKeyfile(keyfile string) (*ArweaveSigner, error) {
 		return nil, fmt.Errorf("failed to create signer from keyfile: %w", err)
 	}
 
+	return newArweaveSigner(signer)
+}
+
+// GenerateArweaveWallet creates a new Arweave signer holding a fresh RSA key of the given
+// size. Zero bits means DefaultArweaveKeyBits, the only size data items accept. Use
+// WriteKeyfile or WriteEncryptedKeyfile to persist the wallet.
+func GenerateArweaveWallet(bits int) (*ArweaveSigner, error) {
+	if bits == 0 {
+		bits = DefaultArweaveKeyBits
+	}
+	if bits != DefaultArweaveKeyBits {
+		return nil, fmt.Errorf("arweave keys must be %d bits, got %d", DefaultArweaveKeyBits, bits)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate RSA key: %w", err)
+	}
+
+	return newArweaveSigner(goar.NewSignerByPrivateKey(privateKey))
+}
+
+// GenerateArweaveKeyfile creates a new Arweave wallet like GenerateArweaveWallet and writes
+// its JWK to keyfile, readable only by its owner
+func GenerateArweaveKeyfile(keyfile string, bits int) (*ArweaveSigner, error) {
+	signer, err := GenerateArweaveWallet(bits)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := signer.WriteKeyfile(keyfile); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// newArweaveSigner wraps a goar signer, rejecting keys data items cannot be signed with
+func newArweaveSigner(signer *goar.Signer) (*ArweaveSigner, error) {
+	if bits := signer.PubKey.N.BitLen(); bits != DefaultArweaveKeyBits {
+		return nil, fmt.Errorf("arweave keys must be %d bits, got %d", DefaultArweaveKeyBits, bits)
+	}
+
+	// Precompute the CRT values up front so that signing and JWK encoding never mutate the key
+	signer.PrvKey.Precompute()
+
 	itemSigner, err := goar.NewItemSigner(signer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create item signer: %w", err)
@@ -58,11 +120,63 @@ func NewArweaveSignerFromKeyfile(keyfile string) (*ArweaveSigner, error) {
 	}, nil
 }
 
+// arweaveJWK is the JSON Web Key encoding of an Arweave wallet
+type arweaveJWK struct {
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	D   string `json:"d"`
+	P   string `json:"p,omitempty"`
+	Q   string `json:"q,omitempty"`
+	Dp  string `json:"dp,omitempty"`
+	Dq  string `json:"dq,omitempty"`
+	Qi  string `json:"qi,omitempty"`
+}
+
+// JWK returns the wallet encoded as a JSON Web Key, the format of Arweave keyfiles.
+// The CRT parameters are included when the key holds them.
+func (a *ArweaveSigner) JWK() ([]byte, error) {
+	key := a.signer.PrvKey
+	jwk := arweaveJWK{
+		Kty: "RSA",
+		N:   utils.Base64Encode(key.N.Bytes()),
+		E:   utils.Base64Encode(big.NewInt(int64(key.E)).Bytes()),
+		D:   utils.Base64Encode(key.D.Bytes()),
+	}
+	if len(key.Primes) == 2 && key.Precomputed.Dp != nil {
+		jwk.P = utils.Base64Encode(key.Primes[0].Bytes())
+		jwk.Q = utils.Base64Encode(key.Primes[1].Bytes())
+		jwk.Dp = utils.Base64Encode(key.Precomputed.Dp.Bytes())
+		jwk.Dq = utils.Base64Encode(key.Precomputed.Dq.Bytes())
+		jwk.Qi = utils.Base64Encode(key.Precomputed.Qinv.Bytes())
+	}
+
+	return json.Marshal(jwk)
+}
+
+// WriteKeyfile writes the wallet's JWK to a keyfile readable only by its owner
+func (a *ArweaveSigner) WriteKeyfile(keyfile string) error {
+	jwk, err := a.JWK()
+	if err != nil {
+		return fmt.Errorf("failed to encode JWK: %w", err)
+	}
+
+	if err := os.WriteFile(keyfile, jwk, 0o600); err != nil {
+		return fmt.Errorf("failed to write keyfile: %w", err)
+	}
+	return nil
+}
+
 // GetNativeAddress returns the Arweave address of the wallet
 func (a *ArweaveSigner) GetNativeAddress() (string, error) {
 	return a.signer.Address, nil
 }
 
+// GetPublicKey returns the RSA modulus of the wallet, which is the Arweave owner
+func (a *ArweaveSigner) GetPublicKey() ([]byte, error) {
+	return a.signer.PubKey.N.Bytes(), nil
+}
+
 // GetTokenType returns the Arweave token type
 func (a *ArweaveSigner) GetTokenType() turboTypes.TokenType {
 	return turboTypes.TokenTypeArweave
@@ -82,13 +196,7 @@ func (a *ArweaveSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
 // SignDataItem signs a data item and returns the signed bundle item
 func (a *ArweaveSigner) SignDataItem(ctx context.Context, dataItem *DataItem) (types.BundleItem, error) {
 	// Convert our tags to goar tags
-	goarTags := make([]types.Tag, len(dataItem.Tags))
-	for i, tag := range dataItem.Tags {
-		goarTags[i] = types.Tag{
-			Name:  tag.Name,
-			Value: tag.Value,
-		}
-	}
+	goarTags := toGoarTags(dataItem.Tags)
 
 	// Use ItemSigner to create and sign the data item properly
 	bundleItem, err := a.itemSigner.CreateAndSignItem(
@@ -0,0 +1,180 @@
+package signers
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/everFinance/goar/utils"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt parameters used when encrypting Arweave keyfiles
+const (
+	keyfileScryptN = 1 << 17
+	keyfileScryptR = 8
+	keyfileScryptP = 1
+
+	// Bounds on the scrypt parameters accepted from a keyfile, so a corrupted or hostile
+	// envelope cannot make key derivation exhaust memory
+	keyfileMaxScryptN  = 1 << 20
+	keyfileMinSaltSize = 16
+)
+
+// encryptedKeyfile is the envelope of a passphrase-encrypted Arweave keyfile. The JWK is
+// sealed with AES-256-GCM under a key derived from the passphrase with scrypt. Binary
+// fields are base64url encoded without padding.
+//
+//	{
+//	  "version": 1,
+//	  "kdf": "scrypt",
+//	  "kdfparams": {"n": 131072, "r": 8, "p": 1, "salt": "..."},
+//	  "cipher": "aes-256-gcm",
+//	  "nonce": "...",
+//	  "ciphertext": "..."
+//	}
+type encryptedKeyfile struct {
+	Version    int                 `json:"version"`
+	KDF        string              `json:"kdf"`
+	KDFParams  encryptedKeyfileKDF `json:"kdfparams"`
+	Cipher     string              `json:"cipher"`
+	Nonce      string              `json:"nonce"`
+	Ciphertext string              `json:"ciphertext"`
+}
+
+type encryptedKeyfileKDF struct {
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt string `json:"salt"`
+}
+
+// NewArweaveSignerFromEncryptedKeyfile creates a new Arweave signer from a keyfile written
+// by WriteEncryptedKeyfile
+func NewArweaveSignerFromEncryptedKeyfile(keyfile, passphrase string) (*ArweaveSigner, error) {
+	contents, err := os.ReadFile(keyfile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyfile: %w", err)
+	}
+
+	var envelope encryptedKeyfile
+	if err := json.Unmarshal(contents, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse encrypted keyfile: %w", err)
+	}
+	if envelope.Version != 1 || envelope.KDF != "scrypt" || envelope.Cipher != "aes-256-gcm" {
+		return nil, fmt.Errorf("unsupported encrypted keyfile: version %d, kdf %q, cipher %q", envelope.Version, envelope.KDF, envelope.Cipher)
+	}
+
+	salt, err := utils.Base64Decode(envelope.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode salt: %w", err)
+	}
+	if err := checkKeyfileKDF(envelope.KDFParams, salt); err != nil {
+		return nil, err
+	}
+	nonce, err := utils.Base64Decode(envelope.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode nonce: %w", err)
+	}
+	ciphertext, err := utils.Base64Decode(envelope.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+
+	aead, err := keyfileCipher(passphrase, salt, envelope.KDFParams.N, envelope.KDFParams.R, envelope.KDFParams.P)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("nonce length must be %d, got %d", aead.NonceSize(), len(nonce))
+	}
+
+	jwk, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keyfile: wrong passphrase or corrupted file")
+	}
+
+	return NewArweaveSignerFromJSON(jwk)
+}
+
+// WriteEncryptedKeyfile writes the wallet's JWK to a keyfile encrypted with passphrase,
+// readable only by its owner
+func (a *ArweaveSigner) WriteEncryptedKeyfile(keyfile, passphrase string) error {
+	return a.writeEncryptedKeyfile(keyfile, passphrase, keyfileScryptN)
+}
+
+// writeEncryptedKeyfile encrypts the keyfile with the given scrypt cost
+func (a *ArweaveSigner) writeEncryptedKeyfile(keyfile, passphrase string, scryptN int) error {
+	jwk, err := a.JWK()
+	if err != nil {
+		return fmt.Errorf("failed to encode JWK: %w", err)
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	aead, err := keyfileCipher(passphrase, salt, scryptN, keyfileScryptR, keyfileScryptP)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	contents, err := json.Marshal(encryptedKeyfile{
+		Version: 1,
+		KDF:     "scrypt",
+		KDFParams: encryptedKeyfileKDF{
+			N:    scryptN,
+			R:    keyfileScryptR,
+			P:    keyfileScryptP,
+			Salt: utils.Base64Encode(salt),
+		},
+		Cipher:     "aes-256-gcm",
+		Nonce:      utils.Base64Encode(nonce),
+		Ciphertext: utils.Base64Encode(aead.Seal(nil, nonce, jwk, nil)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode encrypted keyfile: %w", err)
+	}
+
+	if err := os.WriteFile(keyfile, contents, 0o600); err != nil {
+		return fmt.Errorf("failed to write keyfile: %w", err)
+	}
+	return nil
+}
+
+// checkKeyfileKDF rejects scrypt parameters outside the bounds this package writes
+func checkKeyfileKDF(params encryptedKeyfileKDF, salt []byte) error {
+	if params.N < 2 || params.N > keyfileMaxScryptN || params.N&(params.N-1) != 0 {
+		return fmt.Errorf("scrypt n must be a power of two no larger than %d, got %d", keyfileMaxScryptN, params.N)
+	}
+	if params.R != keyfileScryptR || params.P != keyfileScryptP {
+		return fmt.Errorf("unsupported scrypt parameters: r %d, p %d", params.R, params.P)
+	}
+	if len(salt) < keyfileMinSaltSize {
+		return fmt.Errorf("salt must be at least %d bytes, got %d", keyfileMinSaltSize, len(salt))
+	}
+	return nil
+}
+
+// keyfileCipher derives the AES-256-GCM cipher of an encrypted keyfile from its passphrase
+func keyfileCipher(passphrase string, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, n, r, p, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,245 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/everFinance/goar/utils"
+)
+
+var (
+	testArweaveWalletOnce sync.Once
+	testArweaveWallet     *ArweaveSigner
+	testArweaveWalletErr  error
+)
+
+// generatedArweaveWallet returns a wallet generated once for the whole test run
+func generatedArweaveWallet(t *testing.T) *ArweaveSigner {
+	t.Helper()
+	testArweaveWalletOnce.Do(func() {
+		testArweaveWallet, testArweaveWalletErr = GenerateArweaveWallet(0)
+	})
+	if testArweaveWalletErr != nil {
+		t.Fatalf("Failed to generate wallet: %v", testArweaveWalletErr)
+	}
+	return testArweaveWallet
+}
+
+func TestGenerateArweaveWallet(t *testing.T) {
+	signer := generatedArweaveWallet(t)
+
+	publicKey, err := signer.GetPublicKey()
+	if err != nil || len(publicKey) != DefaultArweaveKeyBits/8 {
+		t.Fatalf("Expected a %d byte public key, got %d (%v)", DefaultArweaveKeyBits/8, len(publicKey), err)
+	}
+
+	bundleItem, err := signer.SignDataItem(context.Background(), CreateDataItem([]byte("fresh wallet"), nil, "", ""))
+	if err != nil {
+		t.Fatalf("Failed to sign data item: %v", err)
+	}
+	if err := utils.VerifyBundleItem(bundleItem); err != nil {
+		t.Errorf("Expected data item to verify, got %v", err)
+	}
+
+	if _, err := GenerateArweaveWallet(2048); err == nil {
+		t.Error("Expected an error for a 2048-bit key")
+	}
+	if _, err := GenerateArweaveWallet(8192); err == nil {
+		t.Error("Expected an error for an 8192-bit key")
+	}
+}
+
+func TestGenerateArweaveKeyfile(t *testing.T) {
+	keyfile := filepath.Join(t.TempDir(), "wallet.json")
+	signer, err := GenerateArweaveKeyfile(keyfile, 0)
+	if err != nil {
+		t.Fatalf("Failed to generate keyfile: %v", err)
+	}
+
+	loaded, err := NewArweaveSignerFromKeyfile(keyfile)
+	if err != nil {
+		t.Fatalf("Failed to load keyfile: %v", err)
+	}
+	address, _ := signer.GetNativeAddress()
+	if loadedAddress, _ := loaded.GetNativeAddress(); loadedAddress != address {
+		t.Errorf("Expected address %s, got %s", address, loadedAddress)
+	}
+
+	if _, err := GenerateArweaveKeyfile(filepath.Join(t.TempDir(), "short.json"), 2048); err == nil {
+		t.Error("Expected an error for a 2048-bit key")
+	}
+}
+
+func TestArweaveSignerRejectsShortKeys(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	jwk, _ := json.Marshal(map[string]string{
+		"kty": "RSA",
+		"n":   utils.Base64Encode(privateKey.N.Bytes()),
+		"e":   utils.Base64Encode(big.NewInt(int64(privateKey.E)).Bytes()),
+		"d":   utils.Base64Encode(privateKey.D.Bytes()),
+		"p":   utils.Base64Encode(privateKey.Primes[0].Bytes()),
+		"q":   utils.Base64Encode(privateKey.Primes[1].Bytes()),
+	})
+	keyfile := filepath.Join(t.TempDir(), "wallet.json")
+	if err := os.WriteFile(keyfile, jwk, 0o600); err != nil {
+		t.Fatalf("Failed to write keyfile: %v", err)
+	}
+
+	loaders := map[string]func() (*ArweaveSigner, error){
+		"keyfile": func() (*ArweaveSigner, error) { return NewArweaveSignerFromKeyfile(keyfile) },
+		"json":    func() (*ArweaveSigner, error) { return NewArweaveSignerFromJSON(jwk) },
+		"reader":  func() (*ArweaveSigner, error) { return NewArweaveSignerFromReader(bytes.NewReader(jwk)) },
+	}
+	for name, load := range loaders {
+		if _, err := load(); err == nil || !strings.Contains(err.Error(), "4096 bits") {
+			t.Errorf("%s: expected a key size error, got %v", name, err)
+		}
+	}
+}
+
+func TestArweaveSignerKeyfileRoundTrip(t *testing.T) {
+	signer := generatedArweaveWallet(t)
+	address, _ := signer.GetNativeAddress()
+
+	keyfile := filepath.Join(t.TempDir(), "wallet.json")
+	if err := signer.WriteKeyfile(keyfile); err != nil {
+		t.Fatalf("Failed to write keyfile: %v", err)
+	}
+
+	info, err := os.Stat(keyfile)
+	if err != nil {
+		t.Fatalf("Failed to stat keyfile: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected keyfile mode 0600, got %o", info.Mode().Perm())
+	}
+
+	contents, err := os.ReadFile(keyfile)
+	if err != nil {
+		t.Fatalf("Failed to read keyfile: %v", err)
+	}
+	var jwk map[string]interface{}
+	if err := json.Unmarshal(contents, &jwk); err != nil {
+		t.Fatalf("Failed to decode keyfile: %v", err)
+	}
+	for _, field := range []string{"kty", "n", "e", "d", "p", "q", "dp", "dq", "qi"} {
+		if _, ok := jwk[field]; !ok {
+			t.Errorf("Expected keyfile to contain %q", field)
+		}
+	}
+
+	loaders := map[string]func() (*ArweaveSigner, error){
+		"keyfile": func() (*ArweaveSigner, error) { return NewArweaveSignerFromKeyfile(keyfile) },
+		"json":    func() (*ArweaveSigner, error) { return NewArweaveSignerFromJSON(contents) },
+		"reader":  func() (*ArweaveSigner, error) { return NewArweaveSignerFromReader(bytes.NewReader(contents)) },
+		"map":     func() (*ArweaveSigner, error) { return NewArweaveSigner(jwk) },
+	}
+	for name, load := range loaders {
+		loaded, err := load()
+		if err != nil {
+			t.Fatalf("%s: failed to load signer: %v", name, err)
+		}
+		if loadedAddress, _ := loaded.GetNativeAddress(); loadedAddress != address {
+			t.Errorf("%s: expected address %s, got %s", name, address, loadedAddress)
+		}
+	}
+
+	if _, err := NewArweaveSignerFromJSON([]byte("not json")); err == nil {
+		t.Error("Expected an error for an invalid JWK")
+	}
+}
+
+func TestArweaveSignerEncryptedKeyfile(t *testing.T) {
+	signer := generatedArweaveWallet(t)
+	address, _ := signer.GetNativeAddress()
+
+	keyfile := filepath.Join(t.TempDir(), "wallet.enc.json")
+	if err := signer.writeEncryptedKeyfile(keyfile, "correct horse", 1<<10); err != nil {
+		t.Fatalf("Failed to write encrypted keyfile: %v", err)
+	}
+
+	contents, err := os.ReadFile(keyfile)
+	if err != nil {
+		t.Fatalf("Failed to read keyfile: %v", err)
+	}
+	if bytes.Contains(contents, []byte(`"d"`)) {
+		t.Error("Expected the private key to be encrypted")
+	}
+
+	loaded, err := NewArweaveSignerFromEncryptedKeyfile(keyfile, "correct horse")
+	if err != nil {
+		t.Fatalf("Failed to load encrypted keyfile: %v", err)
+	}
+	if loadedAddress, _ := loaded.GetNativeAddress(); loadedAddress != address {
+		t.Errorf("Expected address %s, got %s", address, loadedAddress)
+	}
+
+	_, err = NewArweaveSignerFromEncryptedKeyfile(keyfile, "wrong passphrase")
+	if err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Errorf("Expected wrong passphrase error, got %v", err)
+	}
+
+	plainKeyfile := filepath.Join(t.TempDir(), "wallet.json")
+	if err := signer.WriteKeyfile(plainKeyfile); err != nil {
+		t.Fatalf("Failed to write keyfile: %v", err)
+	}
+	if _, err := NewArweaveSignerFromEncryptedKeyfile(plainKeyfile, "correct horse"); err == nil {
+		t.Error("Expected an error for an unencrypted keyfile")
+	}
+}
+
+func TestArweaveSignerEncryptedKeyfileKDFBounds(t *testing.T) {
+	signer := generatedArweaveWallet(t)
+
+	keyfile := filepath.Join(t.TempDir(), "wallet.enc.json")
+	if err := signer.writeEncryptedKeyfile(keyfile, "correct horse", 1<<10); err != nil {
+		t.Fatalf("Failed to write encrypted keyfile: %v", err)
+	}
+	contents, err := os.ReadFile(keyfile)
+	if err != nil {
+		t.Fatalf("Failed to read keyfile: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*encryptedKeyfileKDF)
+	}{
+		{"huge n", func(kdf *encryptedKeyfileKDF) { kdf.N = 1 << 40 }},
+		{"n not a power of two", func(kdf *encryptedKeyfileKDF) { kdf.N = 1000 }},
+		{"r", func(kdf *encryptedKeyfileKDF) { kdf.R = 1 << 20 }},
+		{"p", func(kdf *encryptedKeyfileKDF) { kdf.P = 16 }},
+		{"short salt", func(kdf *encryptedKeyfileKDF) { kdf.Salt = utils.Base64Encode(make([]byte, 8)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var envelope encryptedKeyfile
+			if err := json.Unmarshal(contents, &envelope); err != nil {
+				t.Fatalf("Failed to parse keyfile: %v", err)
+			}
+			tt.modify(&envelope.KDFParams)
+
+			modified, _ := json.Marshal(envelope)
+			path := filepath.Join(t.TempDir(), "wallet.enc.json")
+			if err := os.WriteFile(path, modified, 0o600); err != nil {
+				t.Fatalf("Failed to write keyfile: %v", err)
+			}
+
+			if _, err := NewArweaveSignerFromEncryptedKeyfile(path, "correct horse"); err == nil {
+				t.Error("Expected an error for out-of-bounds scrypt parameters")
+			}
+		})
+	}
+}
@@ -2,14 +2,24 @@ package signers
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
 	goar "github.com/everFinance/goar"
 	goarTypes "github.com/everFinance/goar/types"
 	goether "github.com/everFinance/goether"
 	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
 )
 
+// DefaultEthereumDerivationPath is the BIP-44 path of the first account of an Ethereum wallet
+const DefaultEthereumDerivationPath = "m/44'/60'/0'/0/0"
+
 // EthereumSigner implements the Signer interface for Ethereum wallets
 type EthereumSigner struct {
 	wallet     string
@@ -40,11 +50,72 @@ func NewEthereumSigner(wallet string) (*EthereumSigner, error) {
 	}, nil
 }
 
+// NewEthereumSignerFromKeystore creates a new Ethereum signer from a Web3 Secret Storage
+// (keystore v3) file encrypted with passphrase
+func NewEthereumSignerFromKeystore(keyfile, passphrase string) (*EthereumSigner, error) {
+	keyJSON, err := os.ReadFile(keyfile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore file: %w", err)
+	}
+
+	return NewEthereumSigner(hex.EncodeToString(crypto.FromECDSA(key.PrivateKey)))
+}
+
+// NewEthereumSignerFromMnemonic creates a new Ethereum signer from a BIP-39 mnemonic, deriving
+// the key at derivationPath. An empty path means DefaultEthereumDerivationPath.
+func NewEthereumSignerFromMnemonic(mnemonic, derivationPath string) (*EthereumSigner, error) {
+	if derivationPath == "" {
+		derivationPath = DefaultEthereumDerivationPath
+	}
+	path, err := accounts.ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path: %w", err)
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	key, err := deriveBIP32Key(seed, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return NewEthereumSigner(hex.EncodeToString(key.Key))
+}
+
+// deriveBIP32Key derives the extended key at path from a BIP-32 seed
+func deriveBIP32Key(seed []byte, path accounts.DerivationPath) (*bip32.Key, error) {
+	key, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		if key, err = key.NewChildKey(index); err != nil {
+			return nil, fmt.Errorf("invalid child key at index %d: %w", index, err)
+		}
+	}
+
+	return key, nil
+}
+
 // GetNativeAddress returns the Ethereum address of the wallet
 func (e *EthereumSigner) GetNativeAddress() (string, error) {
 	return e.Address, nil
 }
 
+// GetPublicKey returns the uncompressed secp256k1 public key of the wallet
+func (e *EthereumSigner) GetPublicKey() ([]byte, error) {
+	return e.signer.GetPublicKey(), nil
+}
+
 // GetTokenType returns the Ethereum token type
 func (e *EthereumSigner) GetTokenType() turboTypes.TokenType {
 	return turboTypes.TokenTypeEthereum
@@ -64,13 +135,7 @@ func (e *EthereumSigner) Sign(ctx context.Context, data []byte) ([]byte, error)
 // SignDataItem signs a data item and returns the signed bundle item
 func (e *EthereumSigner) SignDataItem(ctx context.Context, dataItem *DataItem) (goarTypes.BundleItem, error) {
 	// Convert our tags to goar tags
-	goarTags := make([]goarTypes.Tag, len(dataItem.Tags))
-	for i, tag := range dataItem.Tags {
-		goarTags[i] = goarTypes.Tag{
-			Name:  tag.Name,
-			Value: tag.Value,
-		}
-	}
+	goarTags := toGoarTags(dataItem.Tags)
 
 	// Use ItemSigner to create and sign the data item properly
 	bundleItem, err := e.itemSigner.CreateAndSignItem(
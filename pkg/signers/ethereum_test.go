@@ -0,0 +1,146 @@
+package signers
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/tyler-smith/go-bip32"
+)
+
+func TestNewEthereumSignerFromKeystore(t *testing.T) {
+	expected, err := NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	privateKey, err := crypto.HexToECDSA(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to parse private key: %v", err)
+	}
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}, "correct horse", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("Failed to encrypt key: %v", err)
+	}
+
+	keyfile := filepath.Join(t.TempDir(), "keystore.json")
+	if err := os.WriteFile(keyfile, keyJSON, 0o600); err != nil {
+		t.Fatalf("Failed to write keystore: %v", err)
+	}
+
+	signer, err := NewEthereumSignerFromKeystore(keyfile, "correct horse")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if signer.Address != expected.Address || signer.PublicKey != expected.PublicKey {
+		t.Errorf("Expected address %s, got %s", expected.Address, signer.Address)
+	}
+
+	if _, err := NewEthereumSignerFromKeystore(keyfile, "wrong"); err == nil {
+		t.Error("Expected an error for a wrong passphrase")
+	}
+}
+
+func TestNewEthereumSignerFromMnemonic(t *testing.T) {
+	const mnemonic = "test test test test test test test test test test test junk"
+
+	tests := []struct {
+		path    string
+		address string
+	}{
+		{"", "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{DefaultEthereumDerivationPath, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{"m/44'/60'/0'/0/1", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+	}
+
+	for _, tt := range tests {
+		signer, err := NewEthereumSignerFromMnemonic(mnemonic, tt.path)
+		if err != nil {
+			t.Fatalf("%q: expected no error, got %v", tt.path, err)
+		}
+		if signer.Address != tt.address {
+			t.Errorf("%q: expected address %s, got %s", tt.path, tt.address, signer.Address)
+		}
+	}
+}
+
+func TestDeriveBIP32KeyVectors(t *testing.T) {
+	const hardened = bip32.FirstHardenedChild
+	type bip32Step struct {
+		path accounts.DerivationPath
+		xprv string
+	}
+
+	// Test vectors 1 and 2 from BIP-32, and vector 3 covering master keys with leading zeros
+	tests := []struct {
+		seed  string
+		chain []bip32Step
+	}{
+		{
+			seed: "000102030405060708090a0b0c0d0e0f",
+			chain: []bip32Step{
+				{accounts.DerivationPath{}, "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+				{accounts.DerivationPath{hardened}, "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+				{accounts.DerivationPath{hardened, 1}, "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+				{accounts.DerivationPath{hardened, 1, hardened + 2}, "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+				{accounts.DerivationPath{hardened, 1, hardened + 2, 2}, "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334"},
+				{accounts.DerivationPath{hardened, 1, hardened + 2, 2, 1000000000}, "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+			},
+		},
+		{
+			seed: "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+			chain: []bip32Step{
+				{accounts.DerivationPath{}, "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U"},
+				{accounts.DerivationPath{0}, "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt"},
+				{accounts.DerivationPath{0, hardened + 2147483647}, "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9"},
+				{accounts.DerivationPath{0, hardened + 2147483647, 1}, "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef"},
+				{accounts.DerivationPath{0, hardened + 2147483647, 1, hardened + 2147483646}, "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc"},
+				{accounts.DerivationPath{0, hardened + 2147483647, 1, hardened + 2147483646, 2}, "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j"},
+			},
+		},
+		{
+			seed: "4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be",
+			chain: []bip32Step{
+				{accounts.DerivationPath{}, "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6"},
+				{accounts.DerivationPath{hardened}, "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		seed, err := hex.DecodeString(tt.seed)
+		if err != nil {
+			t.Fatalf("Invalid seed: %v", err)
+		}
+
+		for _, step := range tt.chain {
+			key, err := deriveBIP32Key(seed, step.path)
+			if err != nil {
+				t.Fatalf("Failed to derive %s: %v", step.path, err)
+			}
+			if key.String() != step.xprv {
+				t.Errorf("Seed %s...: expected %s at %s, got %s", tt.seed[:8], step.xprv, step.path, key.String())
+			}
+		}
+	}
+}
+
+func TestNewEthereumSignerFromMnemonicErrors(t *testing.T) {
+	if _, err := NewEthereumSignerFromMnemonic("test test test test test test test test test test test test", ""); err == nil || !strings.Contains(err.Error(), "invalid mnemonic") {
+		t.Errorf("Expected invalid mnemonic error, got %v", err)
+	}
+
+	if _, err := NewEthereumSignerFromMnemonic("test test test test test test test test test test test junk", "m/not/a/path"); err == nil || !strings.Contains(err.Error(), "invalid derivation path") {
+		t.Errorf("Expected invalid derivation path error, got %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package signers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	goarTypes "github.com/everFinance/goar/types"
+)
+
+// EIP-712 schema of typed Ethereum data item signatures, shared with the TypeScript SDKs:
+//
+//	domain:  {name: "Bundlr", version: "1"}
+//	message: Bundlr(bytes Transaction hash,address address)
+//
+// "Transaction hash" holds the message being signed (the ANS-104 deep hash for data items)
+// and "address" the signing wallet.
+var (
+	typedDataDomainSeparator = crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version)")),
+		crypto.Keccak256([]byte("Bundlr")),
+		crypto.Keccak256([]byte("1")),
+	)
+	typedDataMessageTypeHash = crypto.Keccak256([]byte("Bundlr(bytes Transaction hash,address address)"))
+)
+
+// TypedEthereumSigner implements the Signer interface for Ethereum wallets using EIP-712
+// typed-data signatures (ANS-104 signature type 7), which browser wallets display as a
+// readable payload. The owner of a typed data item is the wallet's lowercase hex address
+// rather than its public key.
+type TypedEthereumSigner struct {
+	*EthereumSigner
+	owner string
+}
+
+// NewTypedEthereumSigner creates a new typed Ethereum signer from a private key
+func NewTypedEthereumSigner(wallet string) (*TypedEthereumSigner, error) {
+	signer, err := NewEthereumSigner(wallet)
+	if err != nil {
+		return nil, err
+	}
+	return signer.Typed(), nil
+}
+
+// Typed returns a typed-data signer for the same wallet
+func (e *EthereumSigner) Typed() *TypedEthereumSigner {
+	return &TypedEthereumSigner{
+		EthereumSigner: e,
+		owner:          strings.ToLower(e.Address),
+	}
+}
+
+// GetPublicKey returns the data item owner: the wallet's lowercase 0x-prefixed address
+func (t *TypedEthereumSigner) GetPublicKey() ([]byte, error) {
+	return []byte(t.owner), nil
+}
+
+// GetSignatureType returns the ANS-104 typed Ethereum signature type
+func (t *TypedEthereumSigner) GetSignatureType() int {
+	return TypedEthereumSignType
+}
+
+// Sign signs the EIP-712 typed data wrapping the provided data
+func (t *TypedEthereumSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	signature, err := crypto.Sign(typedDataHash(t.owner, data), t.signer.GetPrivateKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign data: %w", err)
+	}
+
+	// Match the recovery IDs produced by Ethereum wallets
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// RequestSigner returns the plain Ethereum signer, since the payment service verifies
+// request signatures against the wallet's public key rather than its address
+func (t *TypedEthereumSigner) RequestSigner() Signer {
+	return t.EthereumSigner
+}
+
+// SignDataItem signs a data item and returns the signed bundle item
+func (t *TypedEthereumSigner) SignDataItem(ctx context.Context, dataItem *DataItem) (goarTypes.BundleItem, error) {
+	return signDataItem(ctx, t, dataItem)
+}
+
+// VerifyTypedEthereumSignature verifies a typed Ethereum signature of message made by the
+// wallet whose 0x-prefixed address is owner
+func VerifyTypedEthereumSignature(owner, message, signature []byte) error {
+	if !common.IsHexAddress(string(owner)) || len(owner) != 42 {
+		return fmt.Errorf("owner must be a 0x-prefixed address, got %q", owner)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return fmt.Errorf("signature length must be %d, got %d", crypto.SignatureLength, len(signature))
+	}
+
+	sig := append([]byte{}, signature...)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(typedDataHash(string(owner), message), sig)
+	if err != nil {
+		return fmt.Errorf("failed to recover signer: %w", err)
+	}
+
+	if signer := crypto.PubkeyToAddress(*publicKey); signer != common.HexToAddress(string(owner)) {
+		return fmt.Errorf("signature was made by %s, not %s", signer.Hex(), owner)
+	}
+	return nil
+}
+
+// typedDataHash returns the EIP-712 digest signed for message by address
+func typedDataHash(address string, message []byte) []byte {
+	structHash := crypto.Keccak256(
+		typedDataMessageTypeHash,
+		crypto.Keccak256(message),
+		common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32),
+	)
+	return crypto.Keccak256([]byte{0x19, 0x01}, typedDataDomainSeparator, structHash)
+}
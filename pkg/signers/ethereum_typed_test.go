@@ -0,0 +1,187 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/everFinance/goar/utils"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func newTestTypedEthereumSigner(t *testing.T) *TypedEthereumSigner {
+	t.Helper()
+	signer, err := NewTypedEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	return signer
+}
+
+func TestTypedEthereumSignerOwner(t *testing.T) {
+	signer := newTestTypedEthereumSigner(t)
+
+	owner, err := signer.GetPublicKey()
+	if err != nil {
+		t.Fatalf("Failed to get public key: %v", err)
+	}
+	if string(owner) != strings.ToLower(signer.Address) || len(owner) != 42 {
+		t.Errorf("Expected the lowercase address as owner, got %q", owner)
+	}
+
+	if signatureType, _ := SignatureType(signer); signatureType != TypedEthereumSignType {
+		t.Errorf("Expected signature type %d, got %d", TypedEthereumSignType, signatureType)
+	}
+	if signer.GetTokenType() != turboTypes.TokenTypeEthereum {
+		t.Errorf("Expected token type ethereum, got %s", signer.GetTokenType())
+	}
+}
+
+func TestTypedDataHashMatchesEIP712(t *testing.T) {
+	signer := newTestTypedEthereumSigner(t)
+	message := bytes.Repeat([]byte{0xab}, 48)
+
+	expected, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "version", Type: "string"}},
+			"Bundlr":       {{Name: "Transaction hash", Type: "bytes"}, {Name: "address", Type: "address"}},
+		},
+		PrimaryType: "Bundlr",
+		Domain:      apitypes.TypedDataDomain{Name: "Bundlr", Version: "1"},
+		Message: apitypes.TypedDataMessage{
+			"Transaction hash": hexutil.Encode(message),
+			"address":          signer.owner,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to hash typed data: %v", err)
+	}
+
+	if !bytes.Equal(typedDataHash(signer.owner, message), expected) {
+		t.Error("Expected the typed data hash to match go-ethereum's EIP-712 encoding")
+	}
+}
+
+func TestTypedEthereumSignerSign(t *testing.T) {
+	signer := newTestTypedEthereumSigner(t)
+	owner, _ := signer.GetPublicKey()
+
+	signature, err := signer.Sign(context.Background(), []byte("nonce"))
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	if v := signature[64]; v != 27 && v != 28 {
+		t.Errorf("Expected a 27/28 recovery ID, got %d", v)
+	}
+
+	if err := VerifyTypedEthereumSignature(owner, []byte("nonce"), signature); err != nil {
+		t.Errorf("Expected the signature to verify, got %v", err)
+	}
+	if err := VerifyTypedEthereumSignature(owner, []byte("other"), signature); err == nil {
+		t.Error("Expected a signature over other data to fail")
+	}
+	if err := VerifyTypedEthereumSignature([]byte("0x0000000000000000000000000000000000000001"), []byte("nonce"), signature); err == nil {
+		t.Error("Expected a signature by another wallet to fail")
+	}
+	if err := VerifyTypedEthereumSignature([]byte("not an address"), []byte("nonce"), signature); err == nil {
+		t.Error("Expected an invalid owner to fail")
+	}
+}
+
+func TestTypedEthereumSignerSignDataItem(t *testing.T) {
+	signer := newTestTypedEthereumSigner(t)
+
+	data := []byte("typed payload")
+	tags := []turboTypes.Tag{{Name: "Content-Type", Value: "text/plain"}}
+	anchor := utils.Base64Encode(bytes.Repeat([]byte{3}, 32))
+
+	ctx := context.Background()
+	bundleItem, err := signer.SignDataItem(ctx, CreateDataItem(data, tags, "", anchor))
+	if err != nil {
+		t.Fatalf("Failed to sign data item: %v", err)
+	}
+
+	if bundleItem.SignatureType != TypedEthereumSignType {
+		t.Errorf("Expected signature type %d, got %d", TypedEthereumSignType, bundleItem.SignatureType)
+	}
+	if utils.ByteArrayToLong(bundleItem.ItemBinary[:2]) != TypedEthereumSignType {
+		t.Error("Expected the serialized item to carry signature type 7")
+	}
+	if owner := bundleItem.ItemBinary[2+65 : 2+65+42]; string(owner) != signer.owner {
+		t.Errorf("Expected the serialized owner to be the address, got %q", owner)
+	}
+
+	if err := VerifyDataItem(bundleItem.ItemBinary); err != nil {
+		t.Errorf("Expected data item to verify, got %v", err)
+	}
+
+	tampered := append([]byte{}, bundleItem.ItemBinary...)
+	tampered[len(tampered)-1] ^= 1
+	if err := VerifyDataItem(tampered); err == nil {
+		t.Error("Expected a tampered data item to fail verification")
+	}
+	if err := VerifyDataItem(bundleItem.ItemBinary[:100]); err == nil {
+		t.Error("Expected a truncated data item to fail verification")
+	}
+
+	// Streaming signing produces the same item
+	item, err := SignDataItemStream(ctx, signer, func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}, int64(len(data)), tags, "", anchor)
+	if err != nil {
+		t.Fatalf("Failed to stream sign data item: %v", err)
+	}
+	reader, err := item.Open()
+	if err != nil {
+		t.Fatalf("Failed to open data item: %v", err)
+	}
+	streamed, _ := io.ReadAll(reader)
+	reader.Close()
+
+	if !bytes.Equal(streamed, bundleItem.ItemBinary) || item.ID != bundleItem.Id {
+		t.Error("Expected streamed data item to match in-memory signing")
+	}
+}
+
+func TestTypedEthereumSignerRemote(t *testing.T) {
+	local := newTestTypedEthereumSigner(t)
+	remote := newTestRemoteSigner(t, local)
+
+	if signatureType, _ := SignatureType(remote); signatureType != TypedEthereumSignType {
+		t.Errorf("Expected the remote signer to report signature type %d, got %d", TypedEthereumSignType, signatureType)
+	}
+
+	ctx := context.Background()
+	expected, err := local.SignDataItem(ctx, CreateDataItem([]byte("vault"), nil, "", ""))
+	if err != nil {
+		t.Fatalf("Failed to sign locally: %v", err)
+	}
+	bundleItem, err := remote.SignDataItem(ctx, CreateDataItem([]byte("vault"), nil, "", ""))
+	if err != nil {
+		t.Fatalf("Failed to sign remotely: %v", err)
+	}
+
+	if !bytes.Equal(bundleItem.ItemBinary, expected.ItemBinary) {
+		t.Error("Expected the remote data item to match the local one")
+	}
+}
+
+func TestVerifyDataItemDelegatesToGoar(t *testing.T) {
+	signer, err := NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	bundleItem, err := signer.SignDataItem(context.Background(), CreateDataItem([]byte("untyped"), nil, "", ""))
+	if err != nil {
+		t.Fatalf("Failed to sign data item: %v", err)
+	}
+
+	if err := VerifyDataItem(bundleItem.ItemBinary); err != nil {
+		t.Errorf("Expected data item to verify, got %v", err)
+	}
+}
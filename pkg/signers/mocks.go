@@ -10,6 +10,7 @@ import (
 // MockSigner implements the Signer interface for testing
 type MockSigner struct {
 	Address            string
+	PublicKey          []byte
 	TokenType          turboTypes.TokenType
 	SignError          error
 	SignDataItemError  error
@@ -21,6 +22,7 @@ type MockSigner struct {
 func NewMockSigner(address string, tokenType turboTypes.TokenType) *MockSigner {
 	return &MockSigner{
 		Address:    address,
+		PublicKey:  []byte("mock-public-key"),
 		TokenType:  tokenType,
 		SignResult: []byte("mock-signature"),
 		SignDataItemResult: types.BundleItem{
@@ -34,6 +36,11 @@ func (m *MockSigner) GetNativeAddress() (string, error) {
 	return m.Address, nil
 }
 
+// GetPublicKey returns the mock public key
+func (m *MockSigner) GetPublicKey() ([]byte, error) {
+	return m.PublicKey, nil
+}
+
 // GetTokenType returns the mock token type
 func (m *MockSigner) GetTokenType() turboTypes.TokenType {
 	return m.TokenType
@@ -0,0 +1,217 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/everFinance/goar/types"
+	"github.com/everFinance/goar/utils"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// Remote signing protocol
+//
+// A signing service exposes two JSON endpoints below its base URL. Binary values are
+// base64url encoded without padding, and failures are reported with a non-2xx status
+// and a RemoteSignerError body.
+//
+//	GET  {url}/info  -> {"tokenType": "arweave", "address": "...", "publicKey": "...", "signatureType": 1}
+//	POST {url}/sign  {"message": "..."} -> {"signature": "..."}
+//
+// /sign signs message exactly as the wallet's Signer.Sign would: for data items the
+// message is the ANS-104 deep hash, and the signature is embedded in the item as is.
+// signatureType is optional and defaults to the ANS-104 type implied by tokenType.
+const (
+	RemoteSignerInfoPath = "/info"
+	RemoteSignerSignPath = "/sign"
+)
+
+// DefaultRemoteSignerTimeout is the timeout for signing service requests when no client is configured
+const DefaultRemoteSignerTimeout = 30 * time.Second
+
+// RemoteSignerInfo describes the wallet held by a signing service
+type RemoteSignerInfo struct {
+	TokenType turboTypes.TokenType `json:"tokenType"`
+	Address   string               `json:"address"`
+	PublicKey string               `json:"publicKey"`
+
+	// SignatureType is the ANS-104 signature type, when it is not implied by TokenType
+	SignatureType int `json:"signatureType,omitempty"`
+}
+
+// RemoteSignRequest is the body of a sign request
+type RemoteSignRequest struct {
+	Message string `json:"message"`
+}
+
+// RemoteSignResponse is the body of a successful sign response
+type RemoteSignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// RemoteSignerError is the body of a failed signing service response
+type RemoteSignerError struct {
+	Error string `json:"error"`
+}
+
+// RemoteSignerOptions configures a RemoteSigner
+type RemoteSignerOptions struct {
+	Client  *http.Client      // Optional HTTP client; defaults to one with DefaultRemoteSignerTimeout
+	Headers map[string]string // Extra headers sent with every request, e.g. Authorization
+}
+
+// RemoteSigner implements the Signer interface by delegating signatures to an external
+// signing service, so the private key never enters this process
+type RemoteSigner struct {
+	url           string
+	client        *http.Client
+	headers       map[string]string
+	tokenType     turboTypes.TokenType
+	signatureType int
+	publicKey     []byte
+	Address       string
+}
+
+// NewRemoteSigner creates a new remote signer for the signing service at url. The wallet's
+// token type, address and public key are fetched once from the service.
+func NewRemoteSigner(ctx context.Context, url string, opts *RemoteSignerOptions) (*RemoteSigner, error) {
+	if url == "" {
+		return nil, fmt.Errorf("signing service URL is required")
+	}
+	if opts == nil {
+		opts = &RemoteSignerOptions{}
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultRemoteSignerTimeout}
+	}
+
+	r := &RemoteSigner{
+		url:     strings.TrimSuffix(url, "/"),
+		client:  client,
+		headers: opts.Headers,
+	}
+
+	var info RemoteSignerInfo
+	if err := r.do(ctx, http.MethodGet, RemoteSignerInfoPath, nil, &info); err != nil {
+		return nil, fmt.Errorf("failed to get signer info: %w", err)
+	}
+
+	publicKey, err := utils.Base64Decode(info.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+
+	r.tokenType = info.TokenType
+	r.signatureType = info.SignatureType
+	r.publicKey = publicKey
+	r.Address = info.Address
+
+	signatureType, err := SignatureType(r)
+	if err != nil {
+		return nil, err
+	}
+	sigMeta, err := signatureConfig(signatureType)
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKey) != sigMeta.PubLength {
+		return nil, fmt.Errorf("public key length must be %d, got %d", sigMeta.PubLength, len(publicKey))
+	}
+
+	return r, nil
+}
+
+// GetNativeAddress returns the address reported by the signing service
+func (r *RemoteSigner) GetNativeAddress() (string, error) {
+	return r.Address, nil
+}
+
+// GetPublicKey returns the public key reported by the signing service
+func (r *RemoteSigner) GetPublicKey() ([]byte, error) {
+	return r.publicKey, nil
+}
+
+// GetTokenType returns the token type reported by the signing service
+func (r *RemoteSigner) GetTokenType() turboTypes.TokenType {
+	return r.tokenType
+}
+
+// GetSignatureType returns the ANS-104 signature type reported by the signing service,
+// or zero when it is implied by the token type
+func (r *RemoteSigner) GetSignatureType() int {
+	return r.signatureType
+}
+
+// Sign asks the signing service to sign the provided data
+func (r *RemoteSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	var resp RemoteSignResponse
+	if err := r.do(ctx, http.MethodPost, RemoteSignerSignPath, &RemoteSignRequest{Message: utils.Base64Encode(data)}, &resp); err != nil {
+		return nil, fmt.Errorf("failed to sign data: %w", err)
+	}
+
+	signature, err := utils.Base64Decode(resp.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	return signature, nil
+}
+
+// SignDataItem signs a data item through the signing service and returns the signed bundle item
+func (r *RemoteSigner) SignDataItem(ctx context.Context, dataItem *DataItem) (types.BundleItem, error) {
+	return signDataItem(ctx, r, dataItem)
+}
+
+// do sends a JSON request to the signing service and decodes the JSON response into out
+func (r *RemoteSigner) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		encoded, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.url+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range r.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var remoteErr RemoteSignerError
+		if json.Unmarshal(respBody, &remoteErr) == nil && remoteErr.Error != "" {
+			return fmt.Errorf("signing service returned HTTP %d: %s", resp.StatusCode, remoteErr.Error)
+		}
+		return fmt.Errorf("signing service returned HTTP %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode JSON response: %w", err)
+	}
+	return nil
+}
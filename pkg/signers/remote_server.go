@@ -0,0 +1,84 @@
+package signers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/everFinance/goar/utils"
+)
+
+// maxSignRequestSize bounds sign request bodies; a deep hash message is 48 bytes
+const maxSignRequestSize = 64 * 1024
+
+// NewSigningHandler returns a reference implementation of the remote signing protocol
+// that signs with a local Signer. It performs no authentication of its own, so it must
+// only be exposed behind a trusted network boundary or an authenticating proxy.
+func NewSigningHandler(signer Signer) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(RemoteSignerInfoPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeSigningError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		address, err := signer.GetNativeAddress()
+		if err != nil {
+			writeSigningError(w, http.StatusInternalServerError, "failed to get address: "+err.Error())
+			return
+		}
+		publicKey, err := signer.GetPublicKey()
+		if err != nil {
+			writeSigningError(w, http.StatusInternalServerError, "failed to get public key: "+err.Error())
+			return
+		}
+
+		signatureType, err := SignatureType(signer)
+		if err != nil {
+			writeSigningError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeSigningJSON(w, http.StatusOK, RemoteSignerInfo{
+			TokenType:     signer.GetTokenType(),
+			Address:       address,
+			PublicKey:     utils.Base64Encode(publicKey),
+			SignatureType: signatureType,
+		})
+	})
+	mux.HandleFunc(RemoteSignerSignPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeSigningError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		var req RemoteSignRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSignRequestSize)).Decode(&req); err != nil {
+			writeSigningError(w, http.StatusBadRequest, "invalid sign request: "+err.Error())
+			return
+		}
+		message, err := utils.Base64Decode(req.Message)
+		if err != nil || len(message) == 0 {
+			writeSigningError(w, http.StatusBadRequest, "message must be non-empty base64url")
+			return
+		}
+
+		signature, err := signer.Sign(r.Context(), message)
+		if err != nil {
+			writeSigningError(w, http.StatusInternalServerError, "failed to sign message: "+err.Error())
+			return
+		}
+
+		writeSigningJSON(w, http.StatusOK, RemoteSignResponse{Signature: utils.Base64Encode(signature)})
+	})
+	return mux
+}
+
+func writeSigningJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeSigningError(w http.ResponseWriter, status int, message string) {
+	writeSigningJSON(w, status, RemoteSignerError{Error: message})
+}
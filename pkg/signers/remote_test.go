@@ -0,0 +1,182 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/everFinance/goar/utils"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// newTestRemoteSigner serves signer through the reference signing handler and returns a remote signer for it
+func newTestRemoteSigner(t *testing.T, signer Signer) *RemoteSigner {
+	t.Helper()
+	server := httptest.NewServer(NewSigningHandler(signer))
+	t.Cleanup(server.Close)
+
+	remote, err := NewRemoteSigner(context.Background(), server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create remote signer: %v", err)
+	}
+	return remote
+}
+
+func TestRemoteSignerMatchesLocalSigner(t *testing.T) {
+	ethereumSigner, err := NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	solanaSigner, err := NewSolanaSigner(base58.Encode(testSolanaKeypair()))
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	data := []byte("signed elsewhere")
+	tags := []turboTypes.Tag{{Name: "Content-Type", Value: "text/plain"}}
+	anchor := utils.Base64Encode(bytes.Repeat([]byte{9}, 32))
+
+	// Both schemes sign deterministically, so remote items are byte-identical to local ones
+	for name, local := range map[string]Signer{"ethereum": ethereumSigner, "solana": solanaSigner} {
+		remote := newTestRemoteSigner(t, local)
+
+		localAddress, _ := local.GetNativeAddress()
+		if address, _ := remote.GetNativeAddress(); address != localAddress {
+			t.Errorf("%s: expected address %s, got %s", name, localAddress, address)
+		}
+		if remote.GetTokenType() != local.GetTokenType() {
+			t.Errorf("%s: expected token type %s, got %s", name, local.GetTokenType(), remote.GetTokenType())
+		}
+
+		ctx := context.Background()
+		expected, err := local.SignDataItem(ctx, CreateDataItem(data, tags, "", anchor))
+		if err != nil {
+			t.Fatalf("%s: failed to sign locally: %v", name, err)
+		}
+		bundleItem, err := remote.SignDataItem(ctx, CreateDataItem(data, tags, "", anchor))
+		if err != nil {
+			t.Fatalf("%s: failed to sign remotely: %v", name, err)
+		}
+
+		if !bytes.Equal(bundleItem.ItemBinary, expected.ItemBinary) {
+			t.Errorf("%s: expected the remote data item to match the local one", name)
+		}
+		if err := utils.VerifyBundleItem(bundleItem); err != nil {
+			t.Errorf("%s: expected data item to verify, got %v", name, err)
+		}
+
+		item, err := SignDataItemStream(ctx, remote, func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}, int64(len(data)), tags, "", anchor)
+		if err != nil {
+			t.Fatalf("%s: failed to stream sign remotely: %v", name, err)
+		}
+		if item.ID != expected.Id {
+			t.Errorf("%s: expected streamed ID %s, got %s", name, expected.Id, item.ID)
+		}
+	}
+}
+
+func TestRemoteSignerArweave(t *testing.T) {
+	local := generatedArweaveWallet(t)
+	remote := newTestRemoteSigner(t, local)
+
+	bundleItem, err := remote.SignDataItem(context.Background(), CreateDataItem([]byte("vault key"), nil, "", ""))
+	if err != nil {
+		t.Fatalf("Failed to sign remotely: %v", err)
+	}
+
+	decoded, err := utils.DecodeBundleItem(bundleItem.ItemBinary)
+	if err != nil {
+		t.Fatalf("Failed to decode data item: %v", err)
+	}
+	if err := utils.VerifyBundleItem(*decoded); err != nil {
+		t.Errorf("Expected data item to verify, got %v", err)
+	}
+
+	owner, _ := utils.ItemSignerAddr(*decoded)
+	if address, _ := local.GetNativeAddress(); owner != address {
+		t.Errorf("Expected owner %s, got %s", address, owner)
+	}
+}
+
+func TestRemoteSignerSendsHeaders(t *testing.T) {
+	local, err := NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	handler := NewSigningHandler(local)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"unauthorized"}`))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	_, err = NewRemoteSigner(ctx, server.URL, nil)
+	if err == nil || !strings.Contains(err.Error(), "HTTP 401: unauthorized") {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+
+	remote, err := NewRemoteSigner(ctx, server.URL+"/", &RemoteSignerOptions{Headers: map[string]string{"Authorization": "Bearer secret"}})
+	if err != nil {
+		t.Fatalf("Failed to create remote signer: %v", err)
+	}
+	if _, err := remote.Sign(ctx, []byte("nonce")); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestRemoteSignerErrors(t *testing.T) {
+	mockSigner := NewMockSigner("test-address", turboTypes.TokenTypeSolana)
+	mockSigner.PublicKey = bytes.Repeat([]byte{1}, 32)
+	mockSigner.SetSignError(errors.New("vault sealed"))
+	remote := newTestRemoteSigner(t, mockSigner)
+
+	_, err := remote.Sign(context.Background(), []byte("nonce"))
+	if err == nil || !strings.Contains(err.Error(), "vault sealed") {
+		t.Errorf("Expected the service error, got %v", err)
+	}
+
+	// Public keys that do not fit the signature type are rejected up front
+	mockSigner.PublicKey = []byte("short")
+	server := httptest.NewServer(NewSigningHandler(mockSigner))
+	defer server.Close()
+	if _, err := NewRemoteSigner(context.Background(), server.URL, nil); err == nil || !strings.Contains(err.Error(), "public key length") {
+		t.Errorf("Expected public key length error, got %v", err)
+	}
+}
+
+func TestSigningHandlerRejectsInvalidRequests(t *testing.T) {
+	server := httptest.NewServer(NewSigningHandler(NewMockSigner("test-address", turboTypes.TokenTypeArweave)))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+RemoteSignerSignPath, "application/json", strings.NewReader(`{"message":""}`))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an empty message, got %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get(server.URL + RemoteSignerSignPath)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for GET /sign, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,101 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/everFinance/goar/types"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// SolanaSigner implements the Signer interface for Solana wallets, producing ANS-104
+// ed25519 (signature type 2) data items
+type SolanaSigner struct {
+	privateKey ed25519.PrivateKey
+	Address    string
+}
+
+// NewSolanaSigner creates a new Solana signer from a base58 secret key. Both the 64-byte
+// keypair encoding used by Solana wallets and a bare 32-byte seed are accepted.
+func NewSolanaSigner(secretKey string) (*SolanaSigner, error) {
+	decoded := base58.Decode(secretKey)
+	if len(decoded) == 0 {
+		return nil, fmt.Errorf("failed to decode base58 secret key")
+	}
+
+	return newSolanaSigner(decoded)
+}
+
+// NewSolanaSignerFromKeyfile creates a new Solana signer from a Solana CLI keypair file,
+// a JSON array holding the 64 bytes of the keypair
+func NewSolanaSignerFromKeyfile(keyfile string) (*SolanaSigner, error) {
+	contents, err := os.ReadFile(keyfile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyfile: %w", err)
+	}
+
+	var keypair []byte
+	var values []int
+	if err := json.Unmarshal(contents, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse keyfile: %w", err)
+	}
+	for _, value := range values {
+		if value < 0 || value > 255 {
+			return nil, fmt.Errorf("failed to parse keyfile: byte value %d out of range", value)
+		}
+		keypair = append(keypair, byte(value))
+	}
+
+	return newSolanaSigner(keypair)
+}
+
+// newSolanaSigner creates a Solana signer from a 64-byte keypair or a 32-byte seed
+func newSolanaSigner(secretKey []byte) (*SolanaSigner, error) {
+	var privateKey ed25519.PrivateKey
+	switch len(secretKey) {
+	case ed25519.SeedSize:
+		privateKey = ed25519.NewKeyFromSeed(secretKey)
+	case ed25519.PrivateKeySize:
+		privateKey = ed25519.NewKeyFromSeed(secretKey[:ed25519.SeedSize])
+		if !bytes.Equal(privateKey[ed25519.SeedSize:], secretKey[ed25519.SeedSize:]) {
+			return nil, fmt.Errorf("secret key does not match its public key")
+		}
+	default:
+		return nil, fmt.Errorf("secret key length must be %d or %d, got %d", ed25519.SeedSize, ed25519.PrivateKeySize, len(secretKey))
+	}
+
+	return &SolanaSigner{
+		privateKey: privateKey,
+		Address:    base58.Encode(privateKey.Public().(ed25519.PublicKey)),
+	}, nil
+}
+
+// GetNativeAddress returns the base58 public key of the wallet
+func (s *SolanaSigner) GetNativeAddress() (string, error) {
+	return s.Address, nil
+}
+
+// GetPublicKey returns the 32-byte ed25519 public key of the wallet
+func (s *SolanaSigner) GetPublicKey() ([]byte, error) {
+	return []byte(s.privateKey.Public().(ed25519.PublicKey)), nil
+}
+
+// GetTokenType returns the Solana token type
+func (s *SolanaSigner) GetTokenType() turboTypes.TokenType {
+	return turboTypes.TokenTypeSolana
+}
+
+// Sign signs the provided data using the Solana wallet
+func (s *SolanaSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	return ed25519.Sign(s.privateKey, data), nil
+}
+
+// SignDataItem signs a data item and returns the signed bundle item
+func (s *SolanaSigner) SignDataItem(ctx context.Context, dataItem *DataItem) (types.BundleItem, error) {
+	return signDataItem(ctx, s, dataItem)
+}
@@ -0,0 +1,162 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/everFinance/goar/types"
+	"github.com/everFinance/goar/utils"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// testSolanaKeypair returns a deterministic 64-byte Solana keypair
+func testSolanaKeypair() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{42}, ed25519.SeedSize))
+}
+
+func TestNewSolanaSigner(t *testing.T) {
+	keypair := testSolanaKeypair()
+	expectedAddress := base58.Encode(keypair.Public().(ed25519.PublicKey))
+
+	for name, secretKey := range map[string]string{
+		"keypair": base58.Encode(keypair),
+		"seed":    base58.Encode(keypair.Seed()),
+	} {
+		signer, err := NewSolanaSigner(secretKey)
+		if err != nil {
+			t.Fatalf("%s: failed to create signer: %v", name, err)
+		}
+
+		address, err := signer.GetNativeAddress()
+		if err != nil || address != expectedAddress {
+			t.Errorf("%s: expected address %s, got %s (%v)", name, expectedAddress, address, err)
+		}
+
+		if signer.GetTokenType() != turboTypes.TokenTypeSolana {
+			t.Errorf("%s: expected token type solana, got %s", name, signer.GetTokenType())
+		}
+	}
+}
+
+func TestNewSolanaSignerRejectsInvalidKeys(t *testing.T) {
+	mismatched := append([]byte{}, testSolanaKeypair()...)
+	mismatched[63] ^= 1
+
+	for name, secretKey := range map[string]string{
+		"not base58": "0OIl",
+		"too short":  base58.Encode([]byte{1, 2, 3}),
+		"mismatched": base58.Encode(mismatched),
+	} {
+		if _, err := NewSolanaSigner(secretKey); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestNewSolanaSignerFromKeyfile(t *testing.T) {
+	keypair := testSolanaKeypair()
+	values := make([]int, len(keypair))
+	for i, b := range keypair {
+		values[i] = int(b)
+	}
+	contents, err := json.Marshal(values)
+	if err != nil {
+		t.Fatalf("Failed to encode keypair: %v", err)
+	}
+
+	keyfile := filepath.Join(t.TempDir(), "id.json")
+	if err := os.WriteFile(keyfile, contents, 0o600); err != nil {
+		t.Fatalf("Failed to write keyfile: %v", err)
+	}
+
+	signer, err := NewSolanaSignerFromKeyfile(keyfile)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	if signer.Address != base58.Encode(keypair.Public().(ed25519.PublicKey)) {
+		t.Errorf("Unexpected address %s", signer.Address)
+	}
+
+	if _, err := NewSolanaSignerFromKeyfile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected an error for a missing keyfile")
+	}
+}
+
+func TestSolanaSignerSign(t *testing.T) {
+	keypair := testSolanaKeypair()
+	signer, err := NewSolanaSigner(base58.Encode(keypair))
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	signature, err := signer.Sign(context.Background(), []byte("nonce"))
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	publicKey, _ := signer.GetPublicKey()
+	if !ed25519.Verify(publicKey, []byte("nonce"), signature) {
+		t.Error("Expected the signature to verify against the public key")
+	}
+}
+
+func TestSolanaSignerSignDataItem(t *testing.T) {
+	signer, err := NewSolanaSigner(base58.Encode(testSolanaKeypair()))
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	data := []byte("solana payload")
+	tags := []turboTypes.Tag{{Name: "Content-Type", Value: "text/plain"}}
+	anchor := utils.Base64Encode(bytes.Repeat([]byte{7}, 32))
+
+	for name, tags := range map[string][]turboTypes.Tag{"tagged": tags, "untagged": nil} {
+		ctx := context.Background()
+		bundleItem, err := signer.SignDataItem(ctx, CreateDataItem(data, tags, "", anchor))
+		if err != nil {
+			t.Fatalf("%s: failed to sign data item: %v", name, err)
+		}
+
+		decoded, err := utils.DecodeBundleItem(bundleItem.ItemBinary)
+		if err != nil {
+			t.Fatalf("%s: failed to decode data item: %v", name, err)
+		}
+		if decoded.SignatureType != types.ED25519SignType {
+			t.Errorf("%s: expected signature type %d, got %d", name, types.ED25519SignType, decoded.SignatureType)
+		}
+		if err := utils.VerifyBundleItem(*decoded); err != nil {
+			t.Errorf("%s: expected data item to verify, got %v", name, err)
+		}
+
+		owner, err := utils.ItemSignerAddr(*decoded)
+		if err != nil || owner != signer.Address {
+			t.Errorf("%s: expected owner %s, got %s (%v)", name, signer.Address, owner, err)
+		}
+
+		// Streaming signing produces the same item
+		item, err := SignDataItemStream(ctx, signer, func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}, int64(len(data)), tags, "", anchor)
+		if err != nil {
+			t.Fatalf("%s: failed to stream sign data item: %v", name, err)
+		}
+		reader, err := item.Open()
+		if err != nil {
+			t.Fatalf("%s: failed to open data item: %v", name, err)
+		}
+		streamed, _ := io.ReadAll(reader)
+		reader.Close()
+
+		if !bytes.Equal(streamed, bundleItem.ItemBinary) || item.ID != bundleItem.Id {
+			t.Errorf("%s: expected streamed data item to match in-memory signing", name)
+		}
+	}
+}
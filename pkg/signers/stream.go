@@ -0,0 +1,341 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/everFinance/goar/types"
+	"github.com/everFinance/goar/utils"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// signatureTypes maps a signer's token type to its ANS-104 signature type
+var signatureTypes = map[turboTypes.TokenType]int{
+	turboTypes.TokenTypeArweave:  types.ArweaveSignType,
+	turboTypes.TokenTypeArio:     types.ArweaveSignType,
+	turboTypes.TokenTypeEthereum: types.EthereumSignType,
+	turboTypes.TokenTypeMatic:    types.EthereumSignType,
+	turboTypes.TokenTypePol:      types.EthereumSignType,
+	turboTypes.TokenTypeBaseEth:  types.EthereumSignType,
+	turboTypes.TokenTypeKyve:     types.EthereumSignType,
+	turboTypes.TokenTypeSolana:   types.ED25519SignType,
+}
+
+// TypedEthereumSignType is the ANS-104 signature type of EIP-712 typed-data Ethereum signatures
+const TypedEthereumSignType = 7
+
+// signatureConfigs extends goar's signature table with the types it does not know
+var signatureConfigs = map[int]types.SigMeta{
+	TypedEthereumSignType: {SigLength: 65, PubLength: 42, SigName: "typedEthereum"},
+}
+
+// signatureTyper is implemented by signers whose ANS-104 signature type is not implied by their token type
+type signatureTyper interface {
+	GetSignatureType() int
+}
+
+// SignatureType returns the ANS-104 signature type used by the signer
+func SignatureType(signer Signer) (int, error) {
+	if typed, ok := signer.(signatureTyper); ok {
+		if signatureType := typed.GetSignatureType(); signatureType != 0 {
+			return signatureType, nil
+		}
+	}
+
+	signatureType, ok := signatureTypes[signer.GetTokenType()]
+	if !ok {
+		return 0, fmt.Errorf("unsupported token type for data item signing: %s", signer.GetTokenType())
+	}
+	return signatureType, nil
+}
+
+// signatureConfig returns the signature and owner lengths of an ANS-104 signature type
+func signatureConfig(signatureType int) (types.SigMeta, error) {
+	if sigMeta, ok := signatureConfigs[signatureType]; ok {
+		return sigMeta, nil
+	}
+	if sigMeta, ok := types.SigConfigMap[signatureType]; ok {
+		return sigMeta, nil
+	}
+	return types.SigMeta{}, fmt.Errorf("unsupported signature type: %d", signatureType)
+}
+
+// StreamedDataItem is a signed ANS-104 data item whose payload is re-read from its
+// source every time it is opened, so it is never held in memory
+type StreamedDataItem struct {
+	ID       string // Data item ID (base64url SHA-256 of the signature)
+	Header   []byte // Serialized ANS-104 header preceding the payload
+	DataSize int64  // Size of the payload in bytes
+
+	dataStreamFactory func() (io.ReadCloser, error)
+}
+
+// Size returns the total size of the serialized data item
+func (s *StreamedDataItem) Size() int64 {
+	return int64(len(s.Header)) + s.DataSize
+}
+
+// Open returns a fresh reader over the serialized data item (header followed by payload)
+func (s *StreamedDataItem) Open() (io.ReadCloser, error) {
+	data, err := s.dataStreamFactory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open data stream: %w", err)
+	}
+
+	return &streamedDataItemReader{
+		Reader: io.MultiReader(bytes.NewReader(s.Header), io.LimitReader(data, s.DataSize)),
+		data:   data,
+	}, nil
+}
+
+// streamedDataItemReader closes the underlying payload once the item has been read
+type streamedDataItemReader struct {
+	io.Reader
+	data io.Closer
+}
+
+func (r *streamedDataItemReader) Close() error {
+	return r.data.Close()
+}
+
+// SignDataItemStream signs a data item in two streaming passes: the payload is read once
+// to compute the ANS-104 deep hash, and is read again by StreamedDataItem.Open when the
+// item is uploaded. dataStreamFactory must return the same bytes on every call.
+func SignDataItemStream(ctx context.Context, signer Signer, dataStreamFactory func() (io.ReadCloser, error), dataSize int64, tags []turboTypes.Tag, target, anchor string) (*StreamedDataItem, error) {
+	header, err := signDataItemHeader(ctx, signer, tags, target, anchor, func() ([48]byte, error) {
+		// First pass: deep hash the payload without buffering it
+		data, err := dataStreamFactory()
+		if err != nil {
+			return [48]byte{}, fmt.Errorf("failed to open data stream: %w", err)
+		}
+		dataHash, n, err := deepHashStream(data)
+		data.Close()
+		if err != nil {
+			return [48]byte{}, fmt.Errorf("failed to hash data stream: %w", err)
+		}
+		if n != dataSize {
+			return [48]byte{}, fmt.Errorf("data stream size mismatch: expected %d bytes, read %d", dataSize, n)
+		}
+		return dataHash, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamedDataItem{
+		ID:                header.id(),
+		Header:            header.bytes(),
+		DataSize:          dataSize,
+		dataStreamFactory: dataStreamFactory,
+	}, nil
+}
+
+// signDataItem builds an in-memory ANS-104 data item for signers without a goar item
+// signer, signing its deep hash with signer.Sign
+func signDataItem(ctx context.Context, signer Signer, dataItem *DataItem) (types.BundleItem, error) {
+	header, err := signDataItemHeader(ctx, signer, dataItem.Tags, dataItem.Target, dataItem.Anchor, func() ([48]byte, error) {
+		return deepHashBlob(dataItem.Data), nil
+	})
+	if err != nil {
+		return types.BundleItem{}, err
+	}
+
+	headerBytes := header.bytes()
+	itemBinary := make([]byte, 0, len(headerBytes)+len(dataItem.Data))
+	itemBinary = append(itemBinary, headerBytes...)
+	itemBinary = append(itemBinary, dataItem.Data...)
+
+	return types.BundleItem{
+		SignatureType: header.signatureType,
+		Signature:     utils.Base64Encode(header.signature),
+		Owner:         utils.Base64Encode(header.owner),
+		Target:        dataItem.Target,
+		Anchor:        dataItem.Anchor,
+		Tags:          toGoarTags(dataItem.Tags),
+		Data:          utils.Base64Encode(dataItem.Data),
+		Id:            header.id(),
+		TagsBy:        utils.Base64Encode(header.tags),
+		ItemBinary:    itemBinary,
+	}, nil
+}
+
+// signedDataItemHeader holds the fields of a signed ANS-104 data item that precede its payload
+type signedDataItemHeader struct {
+	signatureType int
+	signature     []byte
+	owner         []byte
+	target        []byte
+	anchor        []byte
+	tagCount      int
+	tags          []byte
+}
+
+// signDataItemHeader validates and signs the header of a data item whose payload deep hash
+// is computed by hashData
+func signDataItemHeader(ctx context.Context, signer Signer, tags []turboTypes.Tag, target, anchor string, hashData func() ([48]byte, error)) (*signedDataItemHeader, error) {
+	signatureType, err := SignatureType(signer)
+	if err != nil {
+		return nil, err
+	}
+	sigMeta, err := signatureConfig(signatureType)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := signer.GetPublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key: %w", err)
+	}
+	if len(owner) != sigMeta.PubLength {
+		return nil, fmt.Errorf("public key length must be %d, got %d", sigMeta.PubLength, len(owner))
+	}
+
+	targetBytes, err := decodeOptional32("target", target)
+	if err != nil {
+		return nil, err
+	}
+	anchorBytes, err := decodeOptional32("anchor", anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsBytes := []byte{}
+	if len(tags) > 0 {
+		tagsBytes, err = utils.SerializeTags(toGoarTags(tags))
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize tags: %w", err)
+		}
+	}
+
+	dataHash, err := hashData()
+	if err != nil {
+		return nil, err
+	}
+
+	message := dataItemSignatureMessage(signatureType, owner, targetBytes, anchorBytes, tagsBytes, dataHash)
+
+	signature, err := signer.Sign(ctx, message[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign data item: %w", err)
+	}
+	if len(signature) != sigMeta.SigLength {
+		return nil, fmt.Errorf("signature length must be %d, got %d", sigMeta.SigLength, len(signature))
+	}
+
+	return &signedDataItemHeader{
+		signatureType: signatureType,
+		signature:     signature,
+		owner:         owner,
+		target:        targetBytes,
+		anchor:        anchorBytes,
+		tagCount:      len(tags),
+		tags:          tagsBytes,
+	}, nil
+}
+
+// bytes serializes the header
+func (h *signedDataItemHeader) bytes() []byte {
+	header := make([]byte, 0, 2+len(h.signature)+len(h.owner)+2+len(h.target)+len(h.anchor)+16+len(h.tags))
+	header = append(header, utils.ShortTo2ByteArray(h.signatureType)...)
+	header = append(header, h.signature...)
+	header = append(header, h.owner...)
+	header = appendOptional(header, h.target)
+	header = appendOptional(header, h.anchor)
+	header = append(header, utils.LongTo8ByteArray(h.tagCount)...)
+	header = append(header, utils.LongTo8ByteArray(len(h.tags))...)
+	header = append(header, h.tags...)
+	return header
+}
+
+// id returns the data item ID, the base64url SHA-256 of the signature
+func (h *signedDataItemHeader) id() string {
+	id := sha256.Sum256(h.signature)
+	return utils.Base64Encode(id[:])
+}
+
+// dataItemSignatureMessage returns the ANS-104 deep hash signed for a data item
+func dataItemSignatureMessage(signatureType int, owner, target, anchor, tags []byte, dataHash [48]byte) [48]byte {
+	return deepHashList(
+		deepHashBlob([]byte("dataitem")),
+		deepHashBlob([]byte("1")),
+		deepHashBlob([]byte(strconv.Itoa(signatureType))),
+		deepHashBlob(owner),
+		deepHashBlob(target),
+		deepHashBlob(anchor),
+		deepHashBlob(tags),
+		dataHash,
+	)
+}
+
+// toGoarTags converts our tags to goar tags
+func toGoarTags(tags []turboTypes.Tag) []types.Tag {
+	goarTags := make([]types.Tag, len(tags))
+	for i, tag := range tags {
+		goarTags[i] = types.Tag{
+			Name:  tag.Name,
+			Value: tag.Value,
+		}
+	}
+	return goarTags
+}
+
+// decodeOptional32 decodes an optional base64url target or anchor, which must be 32 bytes
+func decodeOptional32(name, value string) ([]byte, error) {
+	if value == "" {
+		return []byte{}, nil
+	}
+	decoded, err := utils.Base64Decode(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	if len(decoded) != 32 {
+		return nil, fmt.Errorf("%s length must be 32", name)
+	}
+	return decoded, nil
+}
+
+// appendOptional appends a presence byte followed by the value when it is set
+func appendOptional(header, value []byte) []byte {
+	if len(value) == 0 {
+		return append(header, 0)
+	}
+	header = append(header, 1)
+	return append(header, value...)
+}
+
+// deepHashBlob returns the Arweave deep hash of a byte blob
+func deepHashBlob(data []byte) [48]byte {
+	return deepHashTagged(int64(len(data)), sha512.Sum384(data))
+}
+
+// deepHashStream returns the Arweave deep hash of a stream along with the number of bytes read
+func deepHashStream(data io.Reader) ([48]byte, int64, error) {
+	hash := sha512.New384()
+	n, err := io.Copy(hash, data)
+	if err != nil {
+		return [48]byte{}, n, err
+	}
+
+	var blobHash [48]byte
+	copy(blobHash[:], hash.Sum(nil))
+	return deepHashTagged(n, blobHash), n, nil
+}
+
+func deepHashTagged(length int64, blobHash [48]byte) [48]byte {
+	tagHash := sha512.Sum384([]byte("blob" + strconv.FormatInt(length, 10)))
+	return sha512.Sum384(append(tagHash[:], blobHash[:]...))
+}
+
+// deepHashList returns the Arweave deep hash of a list given the deep hashes of its elements
+func deepHashList(elements ...[48]byte) [48]byte {
+	acc := sha512.Sum384([]byte("list" + strconv.Itoa(len(elements))))
+	for _, element := range elements {
+		acc = sha512.Sum384(append(acc[:], element[:]...))
+	}
+	return acc
+}
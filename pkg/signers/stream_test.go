@@ -0,0 +1,104 @@
+package signers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/everFinance/goar/utils"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+const testEthereumPrivateKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
+func TestSignDataItemStreamMatchesInMemorySigning(t *testing.T) {
+	signer, err := NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	data := []byte("streamed payload that is never buffered")
+	tags := []turboTypes.Tag{{Name: "Content-Type", Value: "text/plain"}}
+	anchor := utils.Base64Encode(bytes.Repeat([]byte{7}, 32))
+
+	ctx := context.Background()
+	expected, err := signer.SignDataItem(ctx, CreateDataItem(data, tags, "", anchor))
+	if err != nil {
+		t.Fatalf("Failed to sign data item: %v", err)
+	}
+
+	opens := 0
+	item, err := SignDataItemStream(ctx, signer, func() (io.ReadCloser, error) {
+		opens++
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}, int64(len(data)), tags, "", anchor)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if opens != 1 {
+		t.Errorf("Expected the source to be read once while signing, got %d", opens)
+	}
+
+	reader, err := item.Open()
+	if err != nil {
+		t.Fatalf("Failed to open data item: %v", err)
+	}
+	defer reader.Close()
+
+	streamed, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Failed to read data item: %v", err)
+	}
+
+	if !bytes.Equal(streamed, expected.ItemBinary) {
+		t.Error("Expected streamed data item to match goar's in-memory serialization")
+	}
+
+	if item.ID != expected.Id {
+		t.Errorf("Expected ID '%s', got '%s'", expected.Id, item.ID)
+	}
+
+	if item.Size() != int64(len(expected.ItemBinary)) {
+		t.Errorf("Expected size %d, got %d", len(expected.ItemBinary), item.Size())
+	}
+
+	decoded, err := utils.DecodeBundleItem(streamed)
+	if err != nil {
+		t.Fatalf("Failed to decode data item: %v", err)
+	}
+
+	if err := utils.VerifyBundleItem(*decoded); err != nil {
+		t.Errorf("Expected data item to verify, got %v", err)
+	}
+}
+
+func TestSignDataItemStreamSizeMismatch(t *testing.T) {
+	signer, err := NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	_, err = SignDataItemStream(context.Background(), signer, func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("short")), nil
+	}, 100, nil, "", "")
+
+	if err == nil || !strings.Contains(err.Error(), "size mismatch") {
+		t.Errorf("Expected size mismatch error, got %v", err)
+	}
+}
+
+func TestSignDataItemStreamRejectsInvalidSignatureLength(t *testing.T) {
+	mockSigner := NewMockSigner("test-address", turboTypes.TokenTypeEthereum)
+	mockSigner.PublicKey = bytes.Repeat([]byte{4}, 65)
+
+	_, err := SignDataItemStream(context.Background(), mockSigner, func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("data")), nil
+	}, 4, nil, "", "")
+
+	if err == nil || !strings.Contains(err.Error(), "signature length") {
+		t.Errorf("Expected signature length error, got %v", err)
+	}
+}
@@ -10,11 +10,18 @@ import (
 // Signer interface for different wallet types
 type Signer interface {
 	GetNativeAddress() (string, error)
+	GetPublicKey() ([]byte, error)
 	GetTokenType() turboTypes.TokenType
 	Sign(ctx context.Context, data []byte) ([]byte, error)
 	SignDataItem(ctx context.Context, dataItem *DataItem) (types.BundleItem, error)
 }
 
+// RequestAuthenticator is implemented by signers whose data item keys cannot authenticate
+// API requests. RequestSigner returns the signer used for signed-nonce request headers.
+type RequestAuthenticator interface {
+	RequestSigner() Signer
+}
+
 // DataItem represents a data item to be signed and uploaded
 type DataItem struct {
 	Data   []byte           `json:"data"`
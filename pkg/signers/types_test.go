@@ -64,6 +64,15 @@ func TestMockSigner(t *testing.T) {
 		t.Errorf("Expected address '%s', got '%s'", address, addr)
 	}
 
+	// Test GetPublicKey
+	publicKey, err := mockSigner.GetPublicKey()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if string(publicKey) != "mock-public-key" {
+		t.Errorf("Expected public key 'mock-public-key', got '%s'", string(publicKey))
+	}
+
 	// Test GetTokenType
 	tt := mockSigner.GetTokenType()
 	if tt != tokenType {
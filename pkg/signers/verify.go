@@ -0,0 +1,94 @@
+package signers
+
+import (
+	"fmt"
+
+	"github.com/everFinance/goar/utils"
+)
+
+// VerifyDataItem verifies the signature of a serialized ANS-104 data item. Typed Ethereum
+// items are verified here; every other signature type is verified by goar.
+func VerifyDataItem(itemBinary []byte) error {
+	if len(itemBinary) < 2 {
+		return fmt.Errorf("data item is too short")
+	}
+
+	if utils.ByteArrayToLong(itemBinary[:2]) != TypedEthereumSignType {
+		bundleItem, err := utils.DecodeBundleItem(itemBinary)
+		if err != nil {
+			return fmt.Errorf("failed to decode data item: %w", err)
+		}
+		return utils.VerifyBundleItem(*bundleItem)
+	}
+
+	header, data, err := parseDataItem(itemBinary)
+	if err != nil {
+		return err
+	}
+
+	message := dataItemSignatureMessage(header.signatureType, header.owner, header.target, header.anchor, header.tags, deepHashBlob(data))
+	return VerifyTypedEthereumSignature(header.owner, message[:], header.signature)
+}
+
+// parseDataItem splits a serialized data item into its header and payload
+func parseDataItem(itemBinary []byte) (*signedDataItemHeader, []byte, error) {
+	errTruncated := fmt.Errorf("data item is truncated")
+
+	if len(itemBinary) < 2 {
+		return nil, nil, errTruncated
+	}
+	header := &signedDataItemHeader{signatureType: utils.ByteArrayToLong(itemBinary[:2])}
+	sigMeta, err := signatureConfig(header.signatureType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest := itemBinary[2:]
+	take := func(n int) ([]byte, bool) {
+		if len(rest) < n {
+			return nil, false
+		}
+		field := rest[:n]
+		rest = rest[n:]
+		return field, true
+	}
+	takeOptional := func() ([]byte, bool) {
+		present, ok := take(1)
+		if !ok {
+			return nil, false
+		}
+		if present[0] == 0 {
+			return []byte{}, true
+		}
+		return take(32)
+	}
+
+	var ok bool
+	if header.signature, ok = take(sigMeta.SigLength); !ok {
+		return nil, nil, errTruncated
+	}
+	if header.owner, ok = take(sigMeta.PubLength); !ok {
+		return nil, nil, errTruncated
+	}
+	if header.target, ok = takeOptional(); !ok {
+		return nil, nil, errTruncated
+	}
+	if header.anchor, ok = takeOptional(); !ok {
+		return nil, nil, errTruncated
+	}
+
+	counts, ok := take(16)
+	if !ok {
+		return nil, nil, errTruncated
+	}
+	header.tagCount = utils.ByteArrayToLong(counts[:8])
+	tagsLength := utils.ByteArrayToLong(counts[8:])
+	if tagsLength < 0 {
+		return nil, nil, errTruncated
+	}
+	if header.tags, ok = take(tagsLength); !ok {
+		return nil, nil, errTruncated
+	}
+
+	return header, rest, nil
+}
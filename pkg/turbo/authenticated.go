@@ -13,45 +13,91 @@ import (
 // authenticatedClient implements TurboAuthenticatedClient
 type authenticatedClient struct {
 	TurboUnauthenticatedClient
-	signer signers.Signer
+	signer       signers.Signer
+	publicClient *unauthenticatedClient // sends anonymous lookups without signed-nonce headers
+	walletClient *unauthenticatedClient // sends wallet-scoped requests with signed-nonce headers
+	maxWinc      *types.Winc            // default upload cap for requests without MaxWinc
+	err          error                  // configuration error reported by wallet-scoped methods
 }
 
 // NewAuthenticatedClient creates a new authenticated Turbo client
 func NewAuthenticatedClient(paymentURL, uploadURL string, signer signers.Signer) TurboAuthenticatedClient {
-	unauthClient := NewUnauthenticatedClient(paymentURL, uploadURL)
+	return NewAuthenticatedClientWithHTTPClient(NewDefaultHTTPClient(paymentURL, uploadURL), signer)
+}
+
+// NewAuthenticatedClientWithHTTPClient creates a new authenticated Turbo client that sends
+// all requests through the provided HTTPClient
+func NewAuthenticatedClientWithHTTPClient(httpClient HTTPClient, signer signers.Signer) TurboAuthenticatedClient {
+	return newAuthenticatedClient(newUnauthenticatedClient(httpClient, string(signer.GetTokenType())), signer)
+}
+
+// NewAuthenticatedClientFromConfig creates a new authenticated Turbo client, returning an
+// error when the configured token cannot be used with the signer
+func NewAuthenticatedClientFromConfig(config *TurboConfig, signer signers.Signer) (TurboAuthenticatedClient, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	if err := config.ValidateSigner(signer); err != nil {
+		return nil, err
+	}
+
+	client := newAuthenticatedClient(newUnauthenticatedClientFromConfig(config, string(config.tokenFor(signer))), signer)
+	client.maxWinc = config.MaxWinc
+	return client, nil
+}
+
+// newAuthenticatedClient wraps an unauthenticated client, deriving a signed transport for wallet-scoped calls
+func newAuthenticatedClient(unauthClient *unauthenticatedClient, signer signers.Signer) *authenticatedClient {
+	walletClient := *unauthClient
+	walletClient.httpClient = newSignedHTTPClient(unauthClient.httpClient, signer)
+
 	return &authenticatedClient{
 		TurboUnauthenticatedClient: unauthClient,
 		signer:                     signer,
+		publicClient:               unauthClient,
+		walletClient:               &walletClient,
 	}
 }
 
 // NewAuthenticatedClientForTesting creates a new authenticated Turbo client with HTTPClient injection for testing
 func NewAuthenticatedClientForTesting(httpClient HTTPClient, signer signers.Signer) TurboAuthenticatedClient {
-	unauthClient := NewUnauthenticatedClientForTesting(httpClient)
-	return &authenticatedClient{
-		TurboUnauthenticatedClient: unauthClient,
-		signer:                     signer,
-	}
+	return NewAuthenticatedClientWithHTTPClient(httpClient, signer)
 }
 
-// GetBalanceForSigner returns the credit balance of the authenticated wallet
+// GetBalanceForSigner returns the credit balance of the authenticated wallet. The payment
+// service identifies the wallet from the signed-nonce headers and verifies the signature.
 func (a *authenticatedClient) GetBalanceForSigner(ctx context.Context) (*types.Balance, error) {
-	// Get the wallet address
-	address, err := a.signer.GetNativeAddress()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get wallet address: %w", err)
+	if a.err != nil {
+		return nil, a.err
 	}
 
-	// Use the unauthenticated client's GetBalance method with the wallet address
-	return a.TurboUnauthenticatedClient.GetBalance(ctx, address)
+	return a.walletClient.getBalance(ctx, fmt.Sprintf("%s/v1/balance", a.walletClient.httpClient.GetPaymentURL()))
 }
 
 // Upload signs and uploads data to Turbo
 func (a *authenticatedClient) Upload(ctx context.Context, req *types.UploadRequest) (*types.UploadResult, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
 	if req == nil {
 		return nil, fmt.Errorf("upload request is required")
 	}
 
+	// Create upload context
+	uploadCtx := ctx
+	if req.Context != nil {
+		uploadCtx = req.Context
+	}
+
+	// Re-openable sources are streamed through signing and upload
+	if req.DataStreamFactory != nil {
+		if req.DataSizeFactory == nil {
+			return nil, fmt.Errorf("DataSizeFactory is required with DataStreamFactory")
+		}
+		return a.uploadStream(uploadCtx, req, req.DataStreamFactory, req.DataSizeFactory())
+	}
+
 	// Determine data source
 	var data []byte
 	var err error
@@ -67,20 +113,7 @@ func (a *authenticatedClient) Upload(ctx context.Context, req *types.UploadReque
 		return nil, fmt.Errorf("either Data or DataReader must be provided")
 	}
 
-	// Create upload context
-	uploadCtx := ctx
-	if req.Context != nil {
-		uploadCtx = req.Context
-	}
-
-	// Notify signing start
-	if req.Events != nil && req.Events.OnProgress != nil {
-		req.Events.OnProgress(types.ProgressEvent{
-			TotalBytes:     int64(len(data)),
-			ProcessedBytes: 0,
-			Step:           "signing",
-		})
-	}
+	notifySigningStart(req.Events, int64(len(data)))
 
 	// Create data item
 	dataItem := signers.CreateDataItem(data, req.Tags, req.Target, req.Anchor)
@@ -88,25 +121,17 @@ func (a *authenticatedClient) Upload(ctx context.Context, req *types.UploadReque
 	// Sign the data item
 	bundleItem, err := a.signer.SignDataItem(uploadCtx, dataItem)
 	if err != nil {
-		if req.Events != nil && req.Events.OnSigningError != nil {
-			req.Events.OnSigningError(err)
-		}
-		if req.Events != nil && req.Events.OnError != nil {
-			req.Events.OnError(types.ErrorEvent{Error: err, Step: "signing"})
-		}
+		notifySigningError(req.Events, err)
 		return nil, fmt.Errorf("failed to sign data item: %w", err)
 	}
 
-	// Notify signing success
-	if req.Events != nil && req.Events.OnSigningSuccess != nil {
-		req.Events.OnSigningSuccess()
-	}
-	if req.Events != nil && req.Events.OnProgress != nil {
-		req.Events.OnProgress(types.ProgressEvent{
-			TotalBytes:     int64(len(data)),
-			ProcessedBytes: int64(len(data)),
-			Step:           "signing",
-		})
+	notifySigningSuccess(req.Events, int64(len(data)), true)
+
+	if maxWinc := a.maxWincFor(req); maxWinc != nil {
+		if err := a.checkBudget(uploadCtx, int64(len(bundleItem.ItemBinary)), *maxWinc, req.PaidBy); err != nil {
+			notifyUploadError(req.Events, err)
+			return nil, err
+		}
 	}
 
 	// Create upload request for signed data item
@@ -119,12 +144,102 @@ func (a *authenticatedClient) Upload(ctx context.Context, req *types.UploadReque
 		},
 		Events:  req.Events,
 		Context: uploadCtx,
+		PaidBy:  req.PaidBy,
 	}
 
 	// Upload the signed data item using the unauthenticated client
 	return a.TurboUnauthenticatedClient.UploadSignedDataItem(uploadCtx, uploadReq)
 }
 
+// uploadStream signs and uploads a re-openable source without buffering the payload
+func (a *authenticatedClient) uploadStream(ctx context.Context, req *types.UploadRequest, dataStreamFactory func() (io.ReadCloser, error), size int64) (*types.UploadResult, error) {
+	notifySigningStart(req.Events, size)
+
+	// Only the hashing pass reports signing progress; the upload pass reports its own
+	signing := true
+	signingSource := func() (io.ReadCloser, error) {
+		data, err := dataStreamFactory()
+		if err != nil || !signing {
+			return data, err
+		}
+		return newProgressReader(data, size, types.StepSigning, req.Events), nil
+	}
+
+	dataItem, err := signers.SignDataItemStream(ctx, a.signer, signingSource, size, req.Tags, req.Target, req.Anchor)
+	signing = false
+	if err != nil {
+		notifySigningError(req.Events, err)
+		return nil, fmt.Errorf("failed to sign data item: %w", err)
+	}
+
+	notifySigningSuccess(req.Events, size, false)
+
+	if maxWinc := a.maxWincFor(req); maxWinc != nil {
+		if err := a.checkBudget(ctx, dataItem.Size(), *maxWinc, req.PaidBy); err != nil {
+			notifyUploadError(req.Events, err)
+			return nil, err
+		}
+	}
+
+	uploadReq := &types.SignedDataItemUploadRequest{
+		DataItemStreamFactory: dataItem.Open,
+		DataItemSizeFactory:   dataItem.Size,
+		Events:                req.Events,
+		Context:               ctx,
+		PaidBy:                req.PaidBy,
+	}
+
+	return a.TurboUnauthenticatedClient.UploadSignedDataItem(ctx, uploadReq)
+}
+
+// notifySigningStart dispatches the signing start events
+func notifySigningStart(events *types.UploadEvents, size int64) {
+	if events == nil {
+		return
+	}
+	if events.OnSigningStart != nil {
+		events.OnSigningStart()
+	}
+	if events.OnProgress != nil {
+		events.OnProgress(types.ProgressEvent{
+			TotalBytes:     size,
+			ProcessedBytes: 0,
+			Step:           types.StepSigning,
+		})
+	}
+}
+
+// notifySigningSuccess dispatches the signing success events. Completion progress is
+// skipped when a progress reader has already reported it.
+func notifySigningSuccess(events *types.UploadEvents, size int64, reportProgress bool) {
+	if events == nil {
+		return
+	}
+	if events.OnSigningSuccess != nil {
+		events.OnSigningSuccess()
+	}
+	if reportProgress && events.OnProgress != nil {
+		events.OnProgress(types.ProgressEvent{
+			TotalBytes:     size,
+			ProcessedBytes: size,
+			Step:           types.StepSigning,
+		})
+	}
+}
+
+// notifySigningError dispatches a signing failure to the relevant event callbacks
+func notifySigningError(events *types.UploadEvents, err error) {
+	if events == nil {
+		return
+	}
+	if events.OnSigningError != nil {
+		events.OnSigningError(err)
+	}
+	if events.OnError != nil {
+		events.OnError(types.ErrorEvent{Error: err, Step: types.StepSigning})
+	}
+}
+
 // GetSigner returns the signer associated with this client
 func (a *authenticatedClient) GetSigner() signers.Signer {
 	return a.signer
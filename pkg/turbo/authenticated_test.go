@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -38,7 +39,7 @@ func TestAuthenticatedClientGetBalanceForSigner(t *testing.T) {
 		StatusCode: 200,
 		Body:       io.NopCloser(strings.NewReader(`{"winc":"2000000000","credits":"2.0","currency":"USD"}`)),
 	}
-	mockHTTPClient.SetResponse("https://mock-payment.test/v1/account/balance/arweave?address=test-address", mockResponse)
+	mockHTTPClient.SetResponse("https://mock-payment.test/v1/balance", mockResponse)
 
 	ctx := context.Background()
 	balance, err := client.GetBalanceForSigner(ctx)
@@ -51,13 +52,13 @@ func TestAuthenticatedClientGetBalanceForSigner(t *testing.T) {
 		t.Error("Expected non-nil balance")
 	}
 
-	if balance.WinC != "2000000000" {
+	if balance.WinC.String() != "2000000000" {
 		t.Errorf("Expected WinC '2000000000', got '%s'", balance.WinC)
 	}
 
-	// Verify the correct address was used
+	// The wallet is identified by its signature, not by an address in the URL
 	lastRequest := mockHTTPClient.GetLastRequest()
-	expectedURL := "https://mock-payment.test/v1/account/balance/arweave?address=test-address"
+	expectedURL := "https://mock-payment.test/v1/balance"
 	if lastRequest.URL != expectedURL {
 		t.Errorf("Expected URL '%s', got '%s'", expectedURL, lastRequest.URL)
 	}
@@ -326,7 +327,108 @@ func TestAuthenticatedClientInheritsUnauthenticatedMethods(t *testing.T) {
 		t.Error("Expected non-nil balance")
 	}
 
-	if balance.WinC != "1000000000" {
+	if balance.WinC.String() != "1000000000" {
 		t.Errorf("Expected WinC '1000000000', got '%s'", balance.WinC)
 	}
 }
+
+const testEthereumPrivateKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
+// patternReader produces size deterministic bytes without allocating them up front
+type patternReader struct {
+	remaining int64
+	offset    int64
+}
+
+func (p *patternReader) Read(buf []byte) (int, error) {
+	if p.remaining == 0 {
+		return 0, io.EOF
+	}
+	if int64(len(buf)) > p.remaining {
+		buf = buf[:p.remaining]
+	}
+	for i := range buf {
+		buf[i] = byte((p.offset + int64(i)) % 251)
+	}
+	p.offset += int64(len(buf))
+	p.remaining -= int64(len(buf))
+	return len(buf), nil
+}
+
+func TestAuthenticatedClientUploadStream(t *testing.T) {
+	signer, err := signers.NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	const size = 32 << 20
+	var receivedBytes int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBytes, _ = io.Copy(io.Discard, r.Body)
+		w.Write([]byte(`{"id":"streamed-id"}`))
+	}))
+	defer server.Close()
+
+	client := Authenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL}, signer)
+
+	opens := 0
+	var signingSteps []types.ProgressEvent
+	result, err := client.Upload(context.Background(), &types.UploadRequest{
+		DataStreamFactory: func() (io.ReadCloser, error) {
+			opens++
+			return io.NopCloser(&patternReader{remaining: size}), nil
+		},
+		DataSizeFactory: func() int64 { return size },
+		Tags:            []types.Tag{{Name: "Content-Type", Value: "application/octet-stream"}},
+		Events: &types.UploadEvents{
+			OnProgress: func(event types.ProgressEvent) {
+				if event.Step == types.StepSigning {
+					signingSteps = append(signingSteps, event)
+				}
+			},
+			ProgressByteInterval: 1 << 20,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.ID != "streamed-id" {
+		t.Errorf("Expected ID 'streamed-id', got '%s'", result.ID)
+	}
+
+	// One pass to hash, one pass to upload
+	if opens != 2 {
+		t.Errorf("Expected the source to be opened twice, got %d", opens)
+	}
+
+	if receivedBytes <= size {
+		t.Errorf("Expected header plus %d payload bytes, got %d", size, receivedBytes)
+	}
+
+	// A start event plus one event per MiB hashed
+	if len(signingSteps) != 33 {
+		t.Errorf("Expected 33 signing progress events, got %d", len(signingSteps))
+	}
+
+	if last := signingSteps[len(signingSteps)-1]; last.ProcessedBytes != size {
+		t.Errorf("Expected signing progress to end at %d bytes, got %d", size, last.ProcessedBytes)
+	}
+}
+
+func TestAuthenticatedClientUploadStreamRequiresSize(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("test-address", turboTypes.TokenTypeEthereum)
+	client := NewAuthenticatedClientForTesting(mockHTTPClient, mockSigner)
+
+	_, err := client.Upload(context.Background(), &types.UploadRequest{
+		DataStreamFactory: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("data")), nil
+		},
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "DataSizeFactory is required") {
+		t.Errorf("Expected DataSizeFactory error, got %v", err)
+	}
+}
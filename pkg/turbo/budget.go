@@ -0,0 +1,53 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// ErrBudgetExceeded is returned by Upload when the price of a data item exceeds its MaxWinc cap
+var ErrBudgetExceeded = errors.New("upload budget exceeded")
+
+// maxWincFor returns the cap that applies to an upload request, or nil when the upload is uncapped
+func (a *authenticatedClient) maxWincFor(req *types.UploadRequest) *types.Winc {
+	if req.MaxWinc != nil {
+		return req.MaxWinc
+	}
+	return a.maxWinc
+}
+
+// checkBudget prices a signed data item of the given size and verifies it fits both the
+// cap and the wallet's balance, so over-budget uploads fail before any data is sent
+func (a *authenticatedClient) checkBudget(ctx context.Context, size int64, maxWinc types.Winc, paidBy []string) error {
+	costs, err := a.GetUploadCosts(ctx, []int64{size})
+	if err != nil {
+		return fmt.Errorf("failed to price data item: %w", err)
+	}
+	price := costs[0].Winc
+
+	if price.Cmp(maxWinc) > 0 {
+		return fmt.Errorf("%w: data item of %d bytes costs %s winc, cap is %s winc", ErrBudgetExceeded, size, price, maxWinc)
+	}
+	if price.Sign() <= 0 {
+		return nil
+	}
+
+	balance, err := a.GetBalanceForSigner(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	// Credits shared with the wallet are only spent when the upload names their payers
+	available := balance.WinC
+	if len(paidBy) > 0 && balance.EffectiveBalance.Cmp(available) > 0 {
+		available = balance.EffectiveBalance
+	}
+
+	if price.Cmp(available) > 0 {
+		return fmt.Errorf("%w: data item of %d bytes costs %s winc, balance is %s winc", ErrInsufficientBalance, size, price, available)
+	}
+	return nil
+}
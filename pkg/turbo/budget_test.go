@@ -0,0 +1,196 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// budgetMockClient returns a mock that prices every data item at price and reports the given balance
+func budgetMockClient(price, balance string) *MockHTTPClient {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		switch {
+		case strings.Contains(url, "/v1/price/bytes/"):
+			return jsonResponse(200, `{"winc":"`+price+`","adjustments":[]}`), nil
+		case strings.HasSuffix(url, "/v1/balance"):
+			return jsonResponse(200, balance), nil
+		}
+		return jsonResponse(404, "Not Found"), nil
+	}
+	return mockClient
+}
+
+// uploadPosted reports whether the mock received a data item upload
+func uploadPosted(mockClient *MockHTTPClient) bool {
+	for _, request := range mockClient.RequestHistory {
+		if request.Method == "POST" {
+			return true
+		}
+	}
+	return false
+}
+
+func winc(amount int64) *types.Winc {
+	w := types.NewWinc(amount)
+	return &w
+}
+
+func TestUploadWithinBudget(t *testing.T) {
+	signer, err := signers.NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	mockClient := budgetMockClient("1000", `{"winc":"5000"}`)
+	client := NewAuthenticatedClientForTesting(mockClient, signer)
+
+	_, err = client.Upload(context.Background(), &types.UploadRequest{Data: []byte("budgeted"), MaxWinc: winc(1000)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !uploadPosted(mockClient) {
+		t.Fatal("Expected the data item to be uploaded")
+	}
+
+	// The price is quoted for the signed data item, not the raw payload
+	signedSize := len(mockClient.GetLastRequest().Body)
+	if priceURL := mockClient.RequestHistory[0].URL; !strings.HasSuffix(priceURL, fmt.Sprintf("/v1/price/bytes/%d", signedSize)) {
+		t.Errorf("Expected the %d byte signed data item to be priced, got %s", signedSize, priceURL)
+	}
+}
+
+func TestUploadBudgetExceeded(t *testing.T) {
+	mockClient := budgetMockClient("1001", `{"winc":"5000"}`)
+	client := NewAuthenticatedClientForTesting(mockClient, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+
+	var uploadErr error
+	_, err := client.Upload(context.Background(), &types.UploadRequest{
+		Data:    []byte("too expensive"),
+		MaxWinc: winc(1000),
+		Events:  &types.UploadEvents{OnUploadError: func(err error) { uploadErr = err }},
+	})
+
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("Expected ErrBudgetExceeded, got %v", err)
+	}
+	if uploadErr != err {
+		t.Errorf("Expected OnUploadError to receive the budget error, got %v", uploadErr)
+	}
+	if uploadPosted(mockClient) {
+		t.Error("Expected no upload when the budget is exceeded")
+	}
+}
+
+func TestUploadInsufficientBalance(t *testing.T) {
+	mockClient := budgetMockClient("1000", `{"winc":"999"}`)
+	client := NewAuthenticatedClientForTesting(mockClient, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+
+	_, err := client.Upload(context.Background(), &types.UploadRequest{Data: []byte("underfunded"), MaxWinc: winc(5000)})
+
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Expected ErrInsufficientBalance, got %v", err)
+	}
+	if uploadPosted(mockClient) {
+		t.Error("Expected no upload when the balance is insufficient")
+	}
+}
+
+func TestUploadBudgetCountsSharedCreditsWithPaidBy(t *testing.T) {
+	balance := `{"winc":"0","effectiveBalance":"2000"}`
+
+	mockClient := budgetMockClient("1000", balance)
+	client := NewAuthenticatedClientForTesting(mockClient, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+
+	_, err := client.Upload(context.Background(), &types.UploadRequest{Data: []byte("shared"), MaxWinc: winc(1000), PaidBy: []string{"friend"}})
+	if err != nil {
+		t.Fatalf("Expected shared credits to cover the upload, got %v", err)
+	}
+
+	// Without PaidBy the shared credits are not spent
+	mockClient = budgetMockClient("1000", balance)
+	client = NewAuthenticatedClientForTesting(mockClient, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+
+	_, err = client.Upload(context.Background(), &types.UploadRequest{Data: []byte("shared"), MaxWinc: winc(1000)})
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Expected ErrInsufficientBalance, got %v", err)
+	}
+}
+
+func TestUploadFreeWithinZeroBudget(t *testing.T) {
+	mockClient := budgetMockClient("0", `{"winc":"0"}`)
+	client := NewAuthenticatedClientForTesting(mockClient, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+
+	_, err := client.Upload(context.Background(), &types.UploadRequest{Data: []byte("free"), MaxWinc: winc(0)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Free uploads need no balance
+	for _, request := range mockClient.RequestHistory {
+		if strings.HasSuffix(request.URL, "/v1/balance") {
+			t.Error("Expected no balance lookup for a free upload")
+		}
+	}
+}
+
+func TestUploadConfigMaxWinc(t *testing.T) {
+	mockClient := budgetMockClient("2000", `{"winc":"5000"}`)
+	client, err := NewAuthenticatedClientFromConfig(&TurboConfig{HTTPClient: mockClient, MaxWinc: winc(1000)}, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.Upload(context.Background(), &types.UploadRequest{Data: []byte("capped")}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("Expected the config cap to apply, got %v", err)
+	}
+
+	// A request cap overrides the client default
+	if _, err := client.Upload(context.Background(), &types.UploadRequest{Data: []byte("capped"), MaxWinc: winc(3000)}); err != nil {
+		t.Errorf("Expected the request cap to override the default, got %v", err)
+	}
+}
+
+func TestUploadWithoutBudgetSkipsPricing(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	client := NewAuthenticatedClientForTesting(mockClient, signers.NewMockSigner("test-address", types.TokenTypeArweave))
+
+	if _, err := client.Upload(context.Background(), &types.UploadRequest{Data: []byte("uncapped")}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if mockClient.GetRequestCount() != 1 {
+		t.Errorf("Expected only the upload request, got %d requests", mockClient.GetRequestCount())
+	}
+}
+
+func TestUploadStreamBudgetExceeded(t *testing.T) {
+	signer, err := signers.NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	mockClient := budgetMockClient("1001", `{"winc":"5000"}`)
+	client := NewAuthenticatedClientForTesting(mockClient, signer)
+
+	_, err = client.Upload(context.Background(), &types.UploadRequest{
+		DataStreamFactory: func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("streamed")), nil },
+		DataSizeFactory:   func() int64 { return 8 },
+		MaxWinc:           winc(1000),
+	})
+
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("Expected ErrBudgetExceeded, got %v", err)
+	}
+	if uploadPosted(mockClient) {
+		t.Error("Expected no upload when the budget is exceeded")
+	}
+}
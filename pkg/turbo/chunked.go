@@ -0,0 +1,342 @@
+package turbo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+const (
+	// DefaultChunkSize is the chunk size used when neither the request nor the service specifies one
+	DefaultChunkSize = 5 * 1024 * 1024
+	// DefaultChunkConcurrency is the number of chunks uploaded in parallel by default
+	DefaultChunkConcurrency = 5
+	// DefaultChunkedPollInterval is the delay between finalization status checks
+	DefaultChunkedPollInterval = time.Second
+)
+
+// Chunked upload session statuses reported by the upload service
+const (
+	ChunkedStatusAssembling  = "ASSEMBLING"
+	ChunkedStatusValidating  = "VALIDATING"
+	ChunkedStatusFinalizing  = "FINALIZING"
+	ChunkedStatusFinalized   = "FINALIZED"
+	ChunkedStatusUnderfunded = "UNDERFUNDED"
+	ChunkedStatusInvalid     = "INVALID"
+)
+
+// UploadSignedDataItemChunked uploads a pre-signed data item through a chunked upload session.
+// Setting UploadID resumes an existing session, skipping chunks the service already holds.
+func (c *unauthenticatedClient) UploadSignedDataItemChunked(ctx context.Context, req *types.ChunkedUploadRequest) (*types.UploadResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("upload request is required")
+	}
+
+	result, err := c.uploadChunked(ctx, req)
+	if err != nil {
+		notifyUploadError(req.Events, err)
+		return nil, err
+	}
+
+	if req.Events != nil && req.Events.OnUploadSuccess != nil {
+		req.Events.OnUploadSuccess(result)
+	}
+
+	return result, nil
+}
+
+func (c *unauthenticatedClient) uploadChunked(ctx context.Context, req *types.ChunkedUploadRequest) (*types.UploadResult, error) {
+	ctx = withUploadService(ctx)
+	size := req.DataItemSizeFactory()
+
+	// Create or resume the upload session
+	var info *types.ChunkedUploadInfo
+	var err error
+	if req.UploadID != "" {
+		info, err = c.getChunkedUpload(ctx, req.UploadID)
+	} else {
+		info, err = c.createChunkedUpload(ctx, req.ChunkSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chunkSize := req.ChunkSize
+	if req.UploadID != "" && info.ChunkSize > 0 {
+		// Offsets of a resumed session are fixed by its original chunk size
+		chunkSize = info.ChunkSize
+	}
+	if chunkSize <= 0 {
+		chunkSize = info.ChunkSize
+	}
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	if req.Events != nil && req.Events.OnChunkedUploadStart != nil {
+		req.Events.OnChunkedUploadStart(info.ID)
+	}
+	if req.Events != nil && req.Events.OnUploadStart != nil {
+		req.Events.OnUploadStart()
+	}
+
+	uploaded := make(map[int64]bool, len(info.Chunks))
+	for _, chunk := range info.Chunks {
+		uploaded[chunk[0]] = true
+	}
+
+	if err := c.uploadChunks(ctx, req, info.ID, size, chunkSize, uploaded); err != nil {
+		return nil, err
+	}
+
+	if req.Events != nil && req.Events.OnProgress != nil {
+		req.Events.OnProgress(types.ProgressEvent{
+			TotalBytes:     size,
+			ProcessedBytes: size,
+			Step:           types.StepFinalizing,
+		})
+	}
+
+	if err := c.finalizeChunkedUpload(ctx, info.ID, req.PaidBy); err != nil {
+		return nil, err
+	}
+
+	return c.waitForChunkedUpload(ctx, info.ID, req.PollInterval)
+}
+
+// uploadChunks reads the data item sequentially and uploads its chunks with bounded concurrency.
+// At most MaxConcurrency chunks are held in memory at any time.
+func (c *unauthenticatedClient) uploadChunks(ctx context.Context, req *types.ChunkedUploadRequest, uploadID string, size, chunkSize int64, uploaded map[int64]bool) error {
+	concurrency := req.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultChunkConcurrency
+	}
+
+	dataStream, err := req.DataItemStreamFactory()
+	if err != nil {
+		return fmt.Errorf("failed to create data stream: %w", err)
+	}
+	defer dataStream.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		firstErr  error
+		processed int64
+	)
+	slots := make(chan struct{}, concurrency)
+
+	reportProgress := func(n int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		processed += n
+		if req.Events != nil && req.Events.OnProgress != nil {
+			req.Events.OnProgress(types.ProgressEvent{
+				TotalBytes:     size,
+				ProcessedBytes: processed,
+				Step:           types.StepUploading,
+			})
+		}
+	}
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	reportProgress(0)
+
+	for offset := int64(0); offset < size; offset += chunkSize {
+		length := chunkSize
+		if offset+length > size {
+			length = size - offset
+		}
+
+		// Chunks the service already holds are skipped without being buffered
+		if uploaded[offset] {
+			if _, err := io.CopyN(io.Discard, dataStream, length); err != nil {
+				fail(fmt.Errorf("failed to read chunk at offset %d: %w", offset, err))
+				break
+			}
+			reportProgress(length)
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		chunk := make([]byte, length)
+		if _, err := io.ReadFull(dataStream, chunk); err != nil {
+			<-slots
+			fail(fmt.Errorf("failed to read chunk at offset %d: %w", offset, err))
+			break
+		}
+
+		wg.Add(1)
+		go func(offset int64, chunk []byte) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := c.uploadChunk(ctx, req.Events, uploadID, offset, chunk); err != nil {
+				fail(err)
+				return
+			}
+			reportProgress(int64(len(chunk)))
+		}(offset, chunk)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// chunksURL builds a URL under the chunked upload routes of the upload service
+func (c *unauthenticatedClient) chunksURL(uploadID, suffix string) string {
+	return fmt.Sprintf("%s/v1/chunks/%s/%s/%s", c.httpClient.GetUploadURL(), c.token, uploadID, suffix)
+}
+
+// createChunkedUpload opens a new chunked upload session
+func (c *unauthenticatedClient) createChunkedUpload(ctx context.Context, chunkSize int64) (*types.ChunkedUploadInfo, error) {
+	url := c.chunksURL("-1", "-1")
+	if chunkSize > 0 {
+		url = fmt.Sprintf("%s?chunkSize=%d", url, chunkSize)
+	}
+
+	resp, err := c.get(ctx, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chunked upload: %w", err)
+	}
+
+	var info types.ChunkedUploadInfo
+	if err := ParseJSON(resp, &info); err != nil {
+		return nil, fmt.Errorf("failed to create chunked upload: %w", err)
+	}
+
+	return &info, nil
+}
+
+// getChunkedUpload returns the state of an existing chunked upload session
+func (c *unauthenticatedClient) getChunkedUpload(ctx context.Context, uploadID string) (*types.ChunkedUploadInfo, error) {
+	resp, err := c.get(ctx, c.chunksURL(uploadID, "-1"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chunked upload %s: %w", uploadID, err)
+	}
+
+	var info types.ChunkedUploadInfo
+	if err := ParseJSON(resp, &info); err != nil {
+		return nil, fmt.Errorf("failed to get chunked upload %s: %w", uploadID, err)
+	}
+	if info.ID == "" {
+		info.ID = uploadID
+	}
+
+	return &info, nil
+}
+
+// uploadChunk sends a single chunk, retrying transient failures
+func (c *unauthenticatedClient) uploadChunk(ctx context.Context, events *types.UploadEvents, uploadID string, offset int64, chunk []byte) error {
+	url := c.chunksURL(uploadID, fmt.Sprintf("%d", offset))
+	resp, err := doWithRetry(ctx, c.retryPolicy, types.StepUploading, events, func() (*http.Response, error) {
+		return c.httpClient.Post(ctx, url, bytes.NewReader(chunk), map[string]string{
+			"Content-Type": "application/octet-stream",
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload chunk at offset %d: %w", offset, err)
+	}
+
+	return drainResponse(resp, fmt.Sprintf("failed to upload chunk at offset %d", offset))
+}
+
+// finalizeChunkedUpload asks the service to assemble the uploaded chunks, charging the
+// paid-by addresses when set
+func (c *unauthenticatedClient) finalizeChunkedUpload(ctx context.Context, uploadID string, paidBy []string) error {
+	var headers map[string]string
+	if len(paidBy) > 0 {
+		headers = map[string]string{HeaderPaidBy: strings.Join(paidBy, ",")}
+	}
+
+	url := c.chunksURL(uploadID, "finalize")
+	resp, err := doWithRetry(ctx, c.retryPolicy, types.StepFinalizing, nil, func() (*http.Response, error) {
+		return c.httpClient.Post(ctx, url, nil, headers)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finalize chunked upload %s: %w", uploadID, err)
+	}
+
+	return drainResponse(resp, fmt.Sprintf("failed to finalize chunked upload %s", uploadID))
+}
+
+// waitForChunkedUpload polls the session status until the data item is finalized
+func (c *unauthenticatedClient) waitForChunkedUpload(ctx context.Context, uploadID string, pollInterval time.Duration) (*types.UploadResult, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultChunkedPollInterval
+	}
+
+	for {
+		resp, err := c.get(ctx, c.chunksURL(uploadID, "status"), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chunked upload status: %w", err)
+		}
+
+		var status types.ChunkedUploadStatus
+		if err := ParseJSON(resp, &status); err != nil {
+			return nil, fmt.Errorf("failed to get chunked upload status: %w", err)
+		}
+
+		switch status.Status {
+		case ChunkedStatusFinalized:
+			if status.Receipt == nil {
+				return nil, fmt.Errorf("chunked upload %s finalized without a receipt", uploadID)
+			}
+			return status.Receipt, nil
+		case ChunkedStatusUnderfunded:
+			return nil, fmt.Errorf("chunked upload %s: %w", uploadID, ErrInsufficientBalance)
+		case ChunkedStatusAssembling, ChunkedStatusValidating, ChunkedStatusFinalizing:
+		default:
+			return nil, fmt.Errorf("chunked upload %s failed with status %s", uploadID, status.Status)
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// drainResponse discards a response body, returning a *TurboHTTPError for non-2xx statuses
+func drainResponse(resp *http.Response, message string) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %w", message, newTurboHTTPError(resp, body))
+	}
+
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,274 @@
+package turbo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// fakeChunkService emulates the chunked upload routes of the upload service
+type fakeChunkService struct {
+	mu          sync.Mutex
+	chunks      map[int64][]byte
+	chunkSize   int64
+	inFlight    int
+	maxInFlight int
+	finalized   bool
+	finalStatus string
+	statusPolls int
+}
+
+func newFakeChunkService(chunkSize int64) *fakeChunkService {
+	return &fakeChunkService{
+		chunks:      make(map[int64][]byte),
+		chunkSize:   chunkSize,
+		finalStatus: ChunkedStatusFinalized,
+	}
+}
+
+func (f *fakeChunkService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/chunks/"), "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	uploadID, action := parts[1], parts[2]
+
+	switch {
+	case r.Method == "GET" && uploadID == "-1":
+		json.NewEncoder(w).Encode(types.ChunkedUploadInfo{ID: "upload-1", Min: 1, Max: 1 << 30, ChunkSize: f.chunkSize})
+	case r.Method == "GET" && action == "-1":
+		f.mu.Lock()
+		info := types.ChunkedUploadInfo{ID: uploadID, ChunkSize: f.chunkSize}
+		for offset, chunk := range f.chunks {
+			info.Chunks = append(info.Chunks, [2]int64{offset, int64(len(chunk))})
+		}
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(info)
+	case r.Method == "GET" && action == "status":
+		f.mu.Lock()
+		f.statusPolls++
+		status := ChunkedStatusAssembling
+		if f.finalized && f.statusPolls > 1 {
+			status = f.finalStatus
+		}
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(types.ChunkedUploadStatus{Status: status, Receipt: &types.UploadResult{ID: "chunked-item-id"}})
+	case r.Method == "POST" && action == "finalize":
+		f.mu.Lock()
+		f.finalized = true
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == "POST":
+		offset, _ := strconv.ParseInt(action, 10, 64)
+		f.mu.Lock()
+		f.inFlight++
+		if f.inFlight > f.maxInFlight {
+			f.maxInFlight = f.inFlight
+		}
+		f.mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		time.Sleep(time.Millisecond)
+
+		f.mu.Lock()
+		f.chunks[offset] = body
+		f.inFlight--
+		f.mu.Unlock()
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// assembled returns the chunks concatenated in offset order
+func (f *fakeChunkService) assembled() []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	offsets := make([]int64, 0, len(f.chunks))
+	for offset := range f.chunks {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	var buf bytes.Buffer
+	for _, offset := range offsets {
+		buf.Write(f.chunks[offset])
+	}
+	return buf.Bytes()
+}
+
+func chunkedRequest(data []byte, events *types.UploadEvents) *types.ChunkedUploadRequest {
+	return &types.ChunkedUploadRequest{
+		SignedDataItemUploadRequest: types.SignedDataItemUploadRequest{
+			DataItemStreamFactory: func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(data)), nil
+			},
+			DataItemSizeFactory: func() int64 { return int64(len(data)) },
+			Events:              events,
+		},
+		ChunkSize:      10,
+		MaxConcurrency: 2,
+		PollInterval:   time.Millisecond,
+	}
+}
+
+func TestUploadSignedDataItemChunked(t *testing.T) {
+	service := newFakeChunkService(10)
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+
+	data := []byte(strings.Repeat("0123456789", 9) + "tail")
+	var progress []types.ProgressEvent
+	var uploadID string
+	var mu sync.Mutex
+	req := chunkedRequest(data, &types.UploadEvents{
+		OnProgress: func(event types.ProgressEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			progress = append(progress, event)
+		},
+		OnChunkedUploadStart: func(id string) {
+			uploadID = id
+		},
+	})
+
+	result, err := client.UploadSignedDataItemChunked(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.ID != "chunked-item-id" {
+		t.Errorf("Expected ID 'chunked-item-id', got '%s'", result.ID)
+	}
+
+	if uploadID != "upload-1" {
+		t.Errorf("Expected upload ID 'upload-1', got '%s'", uploadID)
+	}
+
+	if !bytes.Equal(service.assembled(), data) {
+		t.Errorf("Expected service to receive the full data item, got '%s'", service.assembled())
+	}
+
+	if service.maxInFlight > 2 {
+		t.Errorf("Expected at most 2 concurrent chunks, got %d", service.maxInFlight)
+	}
+
+	// One initial event plus one per chunk, then the finalizing step
+	if len(progress) != 12 {
+		t.Fatalf("Expected 12 progress events, got %d", len(progress))
+	}
+
+	if progress[11].Step != types.StepFinalizing {
+		t.Errorf("Expected last event to be the finalizing step, got %s", progress[11].Step)
+	}
+
+	last := progress[10]
+	if last.ProcessedBytes != int64(len(data)) || last.TotalBytes != int64(len(data)) {
+		t.Errorf("Expected final progress %d/%d, got %d/%d", len(data), len(data), last.ProcessedBytes, last.TotalBytes)
+	}
+}
+
+func TestUploadSignedDataItemChunkedResume(t *testing.T) {
+	service := newFakeChunkService(10)
+	data := []byte(strings.Repeat("abcdefghij", 5))
+
+	// The first three chunks were uploaded before the interruption
+	for offset := int64(0); offset < 30; offset += 10 {
+		service.chunks[offset] = data[offset : offset+10]
+	}
+
+	var posted []string
+	var mu sync.Mutex
+	tracking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			mu.Lock()
+			posted = append(posted, r.URL.Path)
+			mu.Unlock()
+		}
+		service.ServeHTTP(w, r)
+	})
+	server := httptest.NewServer(tracking)
+	defer server.Close()
+
+	client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+
+	req := chunkedRequest(data, nil)
+	req.UploadID = "upload-1"
+
+	if _, err := client.UploadSignedDataItemChunked(context.Background(), req); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sort.Strings(posted)
+	expected := []string{
+		"/v1/chunks/arweave/upload-1/30",
+		"/v1/chunks/arweave/upload-1/40",
+		"/v1/chunks/arweave/upload-1/finalize",
+	}
+	if fmt.Sprint(posted) != fmt.Sprint(expected) {
+		t.Errorf("Expected only missing chunks to be uploaded, got %v", posted)
+	}
+
+	if !bytes.Equal(service.assembled(), data) {
+		t.Errorf("Expected resumed upload to complete the data item, got '%s'", service.assembled())
+	}
+}
+
+func TestUploadSignedDataItemChunkedUnderfunded(t *testing.T) {
+	service := newFakeChunkService(10)
+	service.finalStatus = ChunkedStatusUnderfunded
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+
+	var uploadErr error
+	req := chunkedRequest([]byte("some data item bytes"), &types.UploadEvents{
+		OnUploadError: func(err error) {
+			uploadErr = err
+		},
+	})
+
+	_, err := client.UploadSignedDataItemChunked(context.Background(), req)
+
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Expected ErrInsufficientBalance, got %v", err)
+	}
+
+	if uploadErr == nil {
+		t.Error("Expected OnUploadError to be called")
+	}
+}
+
+func TestUploadSignedDataItemChunkedChunkFailure(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"id":"upload-1","chunkSize":10}`))}, nil
+	}
+	mockClient.PostFunc = func(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
+		return &http.Response{StatusCode: 413, Body: io.NopCloser(strings.NewReader("chunk too large"))}, nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	_, err := client.UploadSignedDataItemChunked(context.Background(), chunkedRequest([]byte("0123456789abcdefghij"), nil))
+
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("Expected ErrPayloadTooLarge, got %v", err)
+	}
+}
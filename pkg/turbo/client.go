@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -17,48 +18,113 @@ type HTTPClient interface {
 	GetUploadURL() string
 }
 
+// DefaultPaymentTimeout is the request timeout applied to payment service calls when none is configured
+const DefaultPaymentTimeout = 30 * time.Second
+
+// DefaultUserAgent is sent with every request unless TurboConfig.UserAgent overrides it
+const DefaultUserAgent = "go-ardrive-turbo"
+
 // defaultHTTPClient implements HTTPClient using Go's standard http.Client
 type defaultHTTPClient struct {
-	client     *http.Client
-	paymentURL string
-	uploadURL  string
+	paymentClient *http.Client
+	uploadClient  *http.Client
+	paymentURL    string
+	uploadURL     string
+	userAgent     string
+	headers       map[string]string
 }
 
 // NewDefaultHTTPClient creates a new default HTTP client
 func NewDefaultHTTPClient(paymentURL, uploadURL string) HTTPClient {
+	return NewHTTPClient(&TurboConfig{
+		PaymentURL: paymentURL,
+		UploadURL:  uploadURL,
+	})
+}
+
+// NewHTTPClient creates an HTTP client honouring the transport, timeout, proxy, TLS,
+// user agent and header settings of the provided config
+func NewHTTPClient(config *TurboConfig) HTTPClient {
+	paymentTimeout := config.PaymentTimeout
+	if paymentTimeout == 0 && config.Client == nil {
+		paymentTimeout = DefaultPaymentTimeout
+	}
+
+	userAgent := config.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+
+	transport := config.transport()
+
 	return &defaultHTTPClient{
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		paymentURL: paymentURL,
-		uploadURL:  uploadURL,
+		paymentClient: config.serviceClient(transport, paymentTimeout),
+		uploadClient:  config.serviceClient(transport, config.UploadTimeout),
+		paymentURL:    config.PaymentURL,
+		uploadURL:     config.UploadURL,
+		userAgent:     userAgent,
+		headers:       config.Headers,
 	}
 }
 
 func (c *defaultHTTPClient) Get(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	return c.do(ctx, "GET", url, nil, headers)
+}
+
+func (c *defaultHTTPClient) Post(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	return c.do(ctx, "POST", url, body, headers)
+}
+
+func (c *defaultHTTPClient) do(ctx context.Context, method, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Set("User-Agent", c.userAgent)
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
 	for key, value := range headers {
 		req.Header.Set(key, value)
 	}
 
-	return c.client.Do(req)
+	return c.clientFor(ctx, url).Do(req)
 }
 
-func (c *defaultHTTPClient) Post(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
-	if err != nil {
-		return nil, err
-	}
+// uploadServiceKey marks the context of a request addressed to the upload service
+type uploadServiceKey struct{}
 
-	for key, value := range headers {
-		req.Header.Set(key, value)
+// withUploadService marks requests made with ctx as addressed to the upload service, so
+// they use the upload http.Client even when both services share a base URL
+func withUploadService(ctx context.Context) context.Context {
+	return context.WithValue(ctx, uploadServiceKey{}, true)
+}
+
+// clientFor selects the upload or payment http.Client for a request. Requests the SDK
+// makes to the upload service are marked on their context; other requests go to the
+// service whose base URL matches the longest part of the URL, payment on a tie.
+func (c *defaultHTTPClient) clientFor(ctx context.Context, url string) *http.Client {
+	if upload, _ := ctx.Value(uploadServiceKey{}).(bool); upload {
+		return c.uploadClient
+	}
+	if uploadMatch := baseURLMatch(url, c.uploadURL); uploadMatch > 0 && uploadMatch > baseURLMatch(url, c.paymentURL) {
+		return c.uploadClient
 	}
+	return c.paymentClient
+}
 
-	return c.client.Do(req)
+// baseURLMatch returns the length of base when url is base or lies below it, and zero otherwise
+func baseURLMatch(url, base string) int {
+	base = strings.TrimSuffix(base, "/")
+	if base == "" {
+		return 0
+	}
+	rest, ok := strings.CutPrefix(url, base)
+	if !ok || (rest != "" && rest[0] != '/' && rest[0] != '?') {
+		return 0
+	}
+	return len(base)
 }
 
 func (c *defaultHTTPClient) GetPaymentURL() string {
@@ -69,13 +135,14 @@ func (c *defaultHTTPClient) GetUploadURL() string {
 	return c.uploadURL
 }
 
-// ParseJSON parses JSON response body into the provided interface
+// ParseJSON parses JSON response body into the provided interface.
+// Non-2xx responses are returned as a *TurboHTTPError.
 func ParseJSON(resp *http.Response, v interface{}) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return newTurboHTTPError(resp, body)
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
@@ -2,10 +2,14 @@ package turbo
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
 )
@@ -47,12 +51,12 @@ func TestUnauthenticatedClientGetBalance(t *testing.T) {
 		t.Error("Expected non-nil balance")
 	}
 
-	if balance.WinC != "1000000000" {
+	if balance.WinC.String() != "1000000000" {
 		t.Errorf("Expected WinC '1000000000', got '%s'", balance.WinC)
 	}
 
-	if balance.Credits != "1.0" {
-		t.Errorf("Expected Credits '1.0', got '%s'", balance.Credits)
+	if balance.Credits.Credits() != "1" {
+		t.Errorf("Expected Credits '1', got '%s'", balance.Credits.Credits())
 	}
 
 	if balance.Currency != "USD" {
@@ -75,6 +79,118 @@ func TestUnauthenticatedClientGetBalance(t *testing.T) {
 	}
 }
 
+func TestUnauthenticatedClientGetBalanceNotFound(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	// Unknown wallets are reported as a 404 by the payment service
+	mockResponse := &http.Response{
+		StatusCode: 404,
+		Body:       io.NopCloser(strings.NewReader(`User Not Found`)),
+	}
+	mockClient.SetResponse("https://mock-payment.test/v1/account/balance/arweave?address=unknown-address", mockResponse)
+
+	ctx := context.Background()
+	balance, err := client.GetBalance(ctx, "unknown-address")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if balance.WinC.String() != "0" {
+		t.Errorf("Expected WinC '0', got '%s'", balance.WinC)
+	}
+
+	if !balance.NoAccount {
+		t.Error("Expected balance to be marked as having no account")
+	}
+
+	if balance.Currency != "" {
+		t.Errorf("Expected no currency for a missing account, got '%s'", balance.Currency)
+	}
+}
+
+func TestUnauthenticatedClientGetBalanceEmpty(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	mockClient.SetResponse("https://mock-payment.test/v1/account/balance/arweave?address=empty-address", &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+	})
+
+	balance, err := client.GetBalance(context.Background(), "empty-address")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !balance.NoAccount {
+		t.Error("Expected an empty response to be marked as having no account")
+	}
+}
+
+func TestUnauthenticatedClientGetBalanceWithApprovals(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	mockClient.SetResponse("https://mock-payment.test/v1/account/balance/arweave?address=treasury", &http.Response{
+		StatusCode: 200,
+		Body: io.NopCloser(strings.NewReader(`{
+			"winc": "0",
+			"controlledWinc": "5000",
+			"effectiveBalance": "1500",
+			"givenApprovals": [{
+				"approvalDataItemId": "approval-1",
+				"approvedAddress": "user",
+				"payingAddress": "treasury",
+				"approvedWincAmount": "5000",
+				"usedWincAmount": "1200",
+				"creationDate": "2024-01-01T00:00:00.000Z",
+				"expirationDate": "2024-01-02T00:00:00.000Z"
+			}],
+			"receivedApprovals": [{"approvedAddress": "treasury", "payingAddress": "other", "approvedWincAmount": "1500", "usedWincAmount": "0"}]
+		}`)),
+	})
+
+	balance, err := client.GetBalance(context.Background(), "treasury")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// A real account with zero spendable winc is not a missing account
+	if balance.NoAccount {
+		t.Error("Expected an existing account")
+	}
+
+	if balance.WinC.String() != "0" || balance.ControlledWinc.String() != "5000" || balance.EffectiveBalance.String() != "1500" {
+		t.Errorf("Unexpected amounts: winc %s, controlled %s, effective %s", balance.WinC, balance.ControlledWinc, balance.EffectiveBalance)
+	}
+
+	if len(balance.GivenApprovals) != 1 || len(balance.ReceivedApprovals) != 1 {
+		t.Fatalf("Expected one given and one received approval, got %+v", balance)
+	}
+
+	given := balance.GivenApprovals[0]
+	if given.ApprovedAddress != "user" || given.UsedWincAmount.String() != "1200" || given.ExpirationDate != "2024-01-02T00:00:00.000Z" {
+		t.Errorf("Unexpected given approval: %+v", given)
+	}
+}
+
+func TestUnauthenticatedClientWithTokenUsesTokenRoute(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	client := NewUnauthenticatedClientWithHTTPClient(mockClient, "ethereum")
+
+	ctx := context.Background()
+	if _, err := client.GetBalance(ctx, "0xabc"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedURL := "https://mock-payment.test/v1/account/balance/ethereum?address=0xabc"
+	if mockClient.GetLastRequest().URL != expectedURL {
+		t.Errorf("Expected URL '%s', got '%s'", expectedURL, mockClient.GetLastRequest().URL)
+	}
+}
+
 func TestUnauthenticatedClientGetUploadCosts(t *testing.T) {
 	mockClient := NewMockHTTPClient()
 	client := NewUnauthenticatedClientForTesting(mockClient)
@@ -103,7 +219,7 @@ func TestUnauthenticatedClientGetUploadCosts(t *testing.T) {
 		t.Errorf("Expected 2 costs, got %d", len(costs))
 	}
 
-	if costs[0].Winc != "1000" {
+	if costs[0].Winc.String() != "1000" {
 		t.Errorf("Expected first cost Winc '1000', got '%s'", costs[0].Winc)
 	}
 
@@ -111,7 +227,7 @@ func TestUnauthenticatedClientGetUploadCosts(t *testing.T) {
 		t.Errorf("Expected first cost Bytes 1024, got %d", costs[0].Bytes)
 	}
 
-	if costs[1].Winc != "1000000" {
+	if costs[1].Winc.String() != "1000000" {
 		t.Errorf("Expected second cost Winc '1000000', got '%s'", costs[1].Winc)
 	}
 
@@ -313,3 +429,210 @@ func TestMockHTTPClient(t *testing.T) {
 		t.Errorf("Expected 0 requests after clear, got %d", mock.GetRequestCount())
 	}
 }
+
+func TestHTTPClientSendsUserAgentAndHeaders(t *testing.T) {
+	var receivedHeaders http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeaders = r.Header
+		w.Write([]byte(`{"winc":"1"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(&TurboConfig{
+		PaymentURL: server.URL,
+		UploadURL:  server.URL,
+		UserAgent:  "my-app/1.0",
+		Headers:    map[string]string{"X-App": "static", "X-Override": "static"},
+	})
+
+	resp, err := client.Get(context.Background(), server.URL, map[string]string{"X-Override": "per-request"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if receivedHeaders.Get("User-Agent") != "my-app/1.0" {
+		t.Errorf("Expected User-Agent 'my-app/1.0', got '%s'", receivedHeaders.Get("User-Agent"))
+	}
+
+	if receivedHeaders.Get("X-App") != "static" {
+		t.Errorf("Expected static header, got '%s'", receivedHeaders.Get("X-App"))
+	}
+
+	if receivedHeaders.Get("X-Override") != "per-request" {
+		t.Errorf("Expected per-request header to win, got '%s'", receivedHeaders.Get("X-Override"))
+	}
+}
+
+func TestHTTPClientDefaultUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+	}))
+	defer server.Close()
+
+	client := NewDefaultHTTPClient(server.URL, server.URL)
+	resp, err := client.Get(context.Background(), server.URL, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if userAgent != DefaultUserAgent {
+		t.Errorf("Expected User-Agent '%s', got '%s'", DefaultUserAgent, userAgent)
+	}
+}
+
+func TestHTTPClientPerServiceTimeouts(t *testing.T) {
+	client := NewHTTPClient(&TurboConfig{
+		PaymentURL:    "https://payment.test",
+		UploadURL:     "https://upload.test",
+		UploadTimeout: 10 * time.Minute,
+	}).(*defaultHTTPClient)
+
+	ctx := context.Background()
+	if client.clientFor(ctx, "https://payment.test/v1/price/bytes/1").Timeout != DefaultPaymentTimeout {
+		t.Errorf("Expected payment timeout %v, got %v", DefaultPaymentTimeout, client.paymentClient.Timeout)
+	}
+
+	if client.clientFor(ctx, "https://upload.test/v1/tx").Timeout != 10*time.Minute {
+		t.Errorf("Expected upload timeout 10m, got %v", client.uploadClient.Timeout)
+	}
+
+	// Without an explicit upload timeout, uploads are bounded only by the context
+	client = NewDefaultHTTPClient("https://payment.test", "https://upload.test").(*defaultHTTPClient)
+	if client.uploadClient.Timeout != 0 {
+		t.Errorf("Expected no default upload timeout, got %v", client.uploadClient.Timeout)
+	}
+}
+
+func TestHTTPClientSharedServiceURL(t *testing.T) {
+	client := NewHTTPClient(&TurboConfig{
+		PaymentURL:     "https://turbo.test",
+		UploadURL:      "https://turbo.test",
+		PaymentTimeout: 5 * time.Second,
+		UploadTimeout:  10 * time.Minute,
+	}).(*defaultHTTPClient)
+
+	if client.clientFor(context.Background(), "https://turbo.test/v1/price/bytes/1") != client.paymentClient {
+		t.Error("Expected payment requests to use the payment client")
+	}
+	if client.clientFor(withUploadService(context.Background()), "https://turbo.test/v1/tx") != client.uploadClient {
+		t.Error("Expected upload requests to use the upload client")
+	}
+}
+
+func TestHTTPClientOverlappingServiceURLs(t *testing.T) {
+	tests := []struct {
+		name       string
+		paymentURL string
+		uploadURL  string
+		url        string
+		upload     bool
+	}{
+		{"payment below upload", "http://host/payment", "http://host", "http://host/payment/v1/rates", false},
+		{"upload below payment", "http://host", "http://host/upload", "http://host/upload/v1/tx", true},
+		{"port prefix", "http://host:30001", "http://host:3000", "http://host:30001/v1/rates", false},
+		{"trailing slash", "http://payment/", "http://upload/", "http://upload/v1/tx", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewHTTPClient(&TurboConfig{PaymentURL: tt.paymentURL, UploadURL: tt.uploadURL}).(*defaultHTTPClient)
+
+			if got := client.clientFor(context.Background(), tt.url) == client.uploadClient; got != tt.upload {
+				t.Errorf("Expected upload client %v for %s, got %v", tt.upload, tt.url, got)
+			}
+		})
+	}
+}
+
+func TestUploadRequestsAddressUploadService(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	var uploadMarked, statusMarked bool
+	mockClient.PostFunc = func(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
+		uploadMarked, _ = ctx.Value(uploadServiceKey{}).(bool)
+		return jsonResponse(200, `{"id":"item-1"}`), nil
+	}
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		statusMarked, _ = ctx.Value(uploadServiceKey{}).(bool)
+		return jsonResponse(200, `{"status":"CONFIRMED"}`), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	ctx := context.Background()
+	if _, err := client.UploadSignedDataItem(ctx, &types.SignedDataItemUploadRequest{
+		DataItemStreamFactory: func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("item")), nil },
+		DataItemSizeFactory:   func() int64 { return 4 },
+	}); err != nil {
+		t.Fatalf("Failed to upload: %v", err)
+	}
+	if _, err := client.GetUploadStatus(ctx, "item-1"); err != nil {
+		t.Fatalf("Failed to get status: %v", err)
+	}
+
+	if !uploadMarked || !statusMarked {
+		t.Errorf("Expected upload service requests to be marked, got upload %v, status %v", uploadMarked, statusMarked)
+	}
+}
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+	return &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(`{"winc":"5"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestFactoryUsesConfiguredTransport(t *testing.T) {
+	transport := &recordingRoundTripper{}
+	client := Unauthenticated(&TurboConfig{
+		PaymentURL: "https://payment.test",
+		UploadURL:  "https://upload.test",
+		Transport:  transport,
+	})
+
+	balance, err := client.GetBalance(context.Background(), "test-address")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if balance.WinC.String() != "5" {
+		t.Errorf("Expected WinC '5', got '%s'", balance.WinC)
+	}
+
+	if len(transport.requests) != 1 {
+		t.Errorf("Expected 1 request through custom transport, got %d", len(transport.requests))
+	}
+}
+
+func TestHTTPClientProxyAndTLSConfig(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.test:8080")
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
+
+	client := NewHTTPClient(&TurboConfig{
+		PaymentURL: "https://payment.test",
+		UploadURL:  "https://upload.test",
+		Proxy:      http.ProxyURL(proxyURL),
+		TLSConfig:  tlsConfig,
+	}).(*defaultHTTPClient)
+
+	transport, ok := client.paymentClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got %T", client.paymentClient.Transport)
+	}
+
+	if transport.TLSClientConfig != tlsConfig {
+		t.Error("Expected TLS config to be applied")
+	}
+
+	req, _ := http.NewRequest("GET", "https://payment.test", nil)
+	if got, _ := transport.Proxy(req); got.String() != proxyURL.String() {
+		t.Errorf("Expected proxy '%s', got '%v'", proxyURL, got)
+	}
+}
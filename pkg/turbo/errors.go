@@ -0,0 +1,74 @@
+package turbo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for well-known Turbo service failures. They match a *TurboHTTPError
+// through errors.Is, so callers never need to inspect status codes directly.
+var (
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrPayloadTooLarge     = errors.New("payload too large")
+	ErrRateLimited         = errors.New("rate limited")
+	ErrAlreadyUploaded     = errors.New("data item already uploaded")
+	ErrServiceUnavailable  = errors.New("service unavailable")
+	ErrNotFound            = errors.New("not found")
+)
+
+// TurboHTTPError is returned when a Turbo service responds with a non-2xx status code
+type TurboHTTPError struct {
+	StatusCode int         // HTTP status code returned by the service
+	Method     string      // HTTP method of the failed request
+	Endpoint   string      // Full URL of the failed request
+	Body       []byte      // Raw response body
+	Header     http.Header // Response headers
+}
+
+// newTurboHTTPError builds a TurboHTTPError from a response whose body has not been consumed yet
+func newTurboHTTPError(resp *http.Response, body []byte) *TurboHTTPError {
+	httpErr := &TurboHTTPError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+		Header:     resp.Header,
+	}
+
+	if resp.Request != nil {
+		httpErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			httpErr.Endpoint = resp.Request.URL.String()
+		}
+	}
+
+	return httpErr
+}
+
+// Error implements the error interface
+func (e *TurboHTTPError) Error() string {
+	if e.Endpoint == "" {
+		return fmt.Sprintf("HTTP %d: %s", e.StatusCode, string(e.Body))
+	}
+	return fmt.Sprintf("HTTP %d from %s %s: %s", e.StatusCode, e.Method, e.Endpoint, string(e.Body))
+}
+
+// Is reports whether the error corresponds to one of the sentinel errors
+func (e *TurboHTTPError) Is(target error) bool {
+	switch target {
+	case ErrInsufficientBalance:
+		return e.StatusCode == http.StatusPaymentRequired
+	case ErrPayloadTooLarge:
+		return e.StatusCode == http.StatusRequestEntityTooLarge
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrAlreadyUploaded:
+		return e.StatusCode == http.StatusConflict
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrServiceUnavailable:
+		return e.StatusCode == http.StatusBadGateway ||
+			e.StatusCode == http.StatusServiceUnavailable ||
+			e.StatusCode == http.StatusGatewayTimeout
+	}
+	return false
+}
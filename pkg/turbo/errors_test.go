@@ -0,0 +1,138 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func TestTurboHTTPErrorFromParseJSON(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/price/bytes/1024", &http.Response{
+		StatusCode: 400,
+		Header:     http.Header{"X-Request-Id": []string{"abc"}},
+		Body:       io.NopCloser(strings.NewReader(`{"error":"Bad Request"}`)),
+	})
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	_, err := client.GetUploadCosts(context.Background(), []int64{1024})
+
+	var httpErr *TurboHTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("Expected *TurboHTTPError, got %T: %v", err, err)
+	}
+
+	if httpErr.StatusCode != 400 {
+		t.Errorf("Expected status 400, got %d", httpErr.StatusCode)
+	}
+
+	if httpErr.Method != "GET" {
+		t.Errorf("Expected method GET, got '%s'", httpErr.Method)
+	}
+
+	if httpErr.Endpoint != "https://mock-payment.test/v1/price/bytes/1024" {
+		t.Errorf("Expected endpoint to be recorded, got '%s'", httpErr.Endpoint)
+	}
+
+	if string(httpErr.Body) != `{"error":"Bad Request"}` {
+		t.Errorf("Expected raw body to be kept, got '%s'", string(httpErr.Body))
+	}
+
+	if httpErr.Header.Get("X-Request-Id") != "abc" {
+		t.Errorf("Expected response headers to be kept, got %v", httpErr.Header)
+	}
+}
+
+func TestTurboHTTPErrorSentinels(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		sentinel   error
+	}{
+		{402, ErrInsufficientBalance},
+		{413, ErrPayloadTooLarge},
+		{429, ErrRateLimited},
+		{409, ErrAlreadyUploaded},
+		{404, ErrNotFound},
+		{502, ErrServiceUnavailable},
+		{503, ErrServiceUnavailable},
+		{504, ErrServiceUnavailable},
+	}
+
+	sentinels := []error{ErrInsufficientBalance, ErrPayloadTooLarge, ErrRateLimited, ErrAlreadyUploaded, ErrNotFound, ErrServiceUnavailable}
+
+	for _, tc := range testCases {
+		err := error(&TurboHTTPError{StatusCode: tc.statusCode})
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == tc.sentinel) {
+				t.Errorf("errors.Is(HTTP %d, %v) = %v", tc.statusCode, sentinel, got)
+			}
+		}
+	}
+}
+
+func TestUploadSignedDataItemInsufficientBalance(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx", &http.Response{
+		StatusCode: 402,
+		Body:       io.NopCloser(strings.NewReader("Insufficient balance")),
+	})
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	var uploadErr error
+	req := &types.SignedDataItemUploadRequest{
+		DataItemStreamFactory: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("test-data-item")), nil
+		},
+		DataItemSizeFactory: func() int64 { return 14 },
+		Events: &types.UploadEvents{
+			OnUploadError: func(err error) {
+				uploadErr = err
+			},
+		},
+	}
+
+	_, err := client.UploadSignedDataItem(context.Background(), req)
+
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Expected ErrInsufficientBalance, got %v", err)
+	}
+
+	if !errors.Is(uploadErr, ErrInsufficientBalance) {
+		t.Errorf("Expected OnUploadError to receive ErrInsufficientBalance, got %v", uploadErr)
+	}
+}
+
+func TestUploadSignedDataItemAlreadyUploaded(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx", &http.Response{
+		StatusCode: 202,
+		Body:       io.NopCloser(strings.NewReader("Data Item Exists")),
+	})
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	var uploadErr error
+	_, err := client.UploadSignedDataItem(context.Background(), &types.SignedDataItemUploadRequest{
+		DataItemStreamFactory: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("test-data-item")), nil
+		},
+		DataItemSizeFactory: func() int64 { return 14 },
+		Events: &types.UploadEvents{
+			OnUploadError: func(err error) {
+				uploadErr = err
+			},
+		},
+	})
+
+	if !errors.Is(err, ErrAlreadyUploaded) {
+		t.Errorf("Expected ErrAlreadyUploaded, got %v", err)
+	}
+
+	if !errors.Is(uploadErr, ErrAlreadyUploaded) {
+		t.Errorf("Expected OnUploadError to receive ErrAlreadyUploaded, got %v", uploadErr)
+	}
+}
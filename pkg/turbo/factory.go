@@ -1,7 +1,13 @@
 package turbo
 
 import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+
 	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
 )
 
 // TurboFactory provides factory methods for creating Turbo clients
@@ -9,23 +15,41 @@ type TurboFactory struct{}
 
 // TurboConfig contains configuration options for creating Turbo clients
 type TurboConfig struct {
-	PaymentURL string // Payment service URL
-	UploadURL  string // Upload service URL
+	PaymentURL  string          // Payment service URL
+	UploadURL   string          // Upload service URL
+	HTTPClient  HTTPClient      // Optional transport; when set, all HTTP settings below are ignored
+	RetryPolicy *RetryPolicy    // Optional retry policy; nil disables retries
+	Token       types.TokenType // Optional token route; defaults to the signer's token type, or arweave when unauthenticated
+	MaxWinc     *types.Winc     // Optional default price cap for authenticated uploads; see UploadRequest.MaxWinc
+
+	PriceConcurrency int         // Parallel requests made by GetUploadCosts; zero means DefaultPriceConcurrency
+	PriceCache       *PriceCache // Optional cache of upload quotes; nil prices every request
+
+	PaymentTimeout time.Duration                         // Timeout for payment service requests; zero means DefaultPaymentTimeout
+	UploadTimeout  time.Duration                         // Timeout for upload service requests; zero leaves cancellation to the context
+	Client         *http.Client                          // Optional base http.Client, copied for each service
+	Transport      http.RoundTripper                     // Optional round tripper; takes precedence over Client's transport, Proxy and TLSConfig
+	UserAgent      string                                // User-Agent header; empty means DefaultUserAgent
+	Headers        map[string]string                     // Extra headers sent with every request
+	Proxy          func(*http.Request) (*url.URL, error) // Optional proxy selector, e.g. http.ProxyURL(u)
+	TLSConfig      *tls.Config                           // Optional TLS settings for the default transport
 }
 
 // DefaultConfig returns the default production configuration
 func DefaultConfig() *TurboConfig {
 	return &TurboConfig{
-		PaymentURL: "https://payment.ardrive.io",
-		UploadURL:  "https://upload.ardrive.io",
+		PaymentURL:  "https://payment.ardrive.io",
+		UploadURL:   "https://upload.ardrive.io",
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 }
 
 // DevConfig returns the development configuration
 func DevConfig() *TurboConfig {
 	return &TurboConfig{
-		PaymentURL: "https://payment.ardrive.dev",
-		UploadURL:  "https://upload.ardrive.dev",
+		PaymentURL:  "https://payment.ardrive.dev",
+		UploadURL:   "https://upload.ardrive.dev",
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 }
 
@@ -35,16 +59,78 @@ func (f *TurboFactory) Unauthenticated(config *TurboConfig) TurboUnauthenticated
 		config = DefaultConfig()
 	}
 
-	return NewUnauthenticatedClient(config.PaymentURL, config.UploadURL)
+	return newUnauthenticatedClientFromConfig(config, string(config.unauthenticatedToken()))
 }
 
-// Authenticated creates a new authenticated Turbo client with the provided signer
+// Authenticated creates a new authenticated Turbo client with the provided signer.
+// If the config does not validate against the signer, the wallet-scoped methods of
+// the returned client fail with the validation error; use NewAuthenticatedClientFromConfig
+// to detect this up front.
 func (f *TurboFactory) Authenticated(config *TurboConfig, signer signers.Signer) TurboAuthenticatedClient {
 	if config == nil {
 		config = DefaultConfig()
 	}
 
-	return NewAuthenticatedClient(config.PaymentURL, config.UploadURL, signer)
+	client, err := NewAuthenticatedClientFromConfig(config, signer)
+	if err != nil {
+		invalidClient := newAuthenticatedClient(newUnauthenticatedClientFromConfig(config, string(config.unauthenticatedToken())), signer)
+		invalidClient.err = err
+		return invalidClient
+	}
+	return client
+}
+
+// httpClient returns the configured transport, falling back to the default HTTP client
+func (c *TurboConfig) httpClient() HTTPClient {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return NewHTTPClient(c)
+}
+
+// transport returns the round tripper shared by the payment and upload clients, or nil
+// to keep the transport of the base client
+func (c *TurboConfig) transport() http.RoundTripper {
+	if c.Transport != nil {
+		return c.Transport
+	}
+	if c.Proxy == nil && c.TLSConfig == nil {
+		return nil
+	}
+
+	var transport *http.Transport
+	if c.Client != nil {
+		if base, ok := c.Client.Transport.(*http.Transport); ok {
+			transport = base.Clone()
+		}
+	}
+	if transport == nil {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	if c.Proxy != nil {
+		transport.Proxy = c.Proxy
+	}
+	if c.TLSConfig != nil {
+		transport.TLSClientConfig = c.TLSConfig
+	}
+
+	return transport
+}
+
+// serviceClient derives the http.Client used for a single service
+func (c *TurboConfig) serviceClient(transport http.RoundTripper, timeout time.Duration) *http.Client {
+	client := &http.Client{}
+	if c.Client != nil {
+		*client = *c.Client
+	}
+	if transport != nil {
+		client.Transport = transport
+	}
+	if timeout != 0 || c.Client == nil {
+		client.Timeout = timeout
+	}
+	return client
 }
 
 // Global factory instance
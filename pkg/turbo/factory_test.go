@@ -1,6 +1,7 @@
 package turbo
 
 import (
+	"context"
 	"testing"
 
 	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
@@ -171,6 +172,30 @@ func TestTurboConfigCustomValues(t *testing.T) {
 	}
 }
 
+func TestTurboConfigHTTPClient(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	config := &TurboConfig{HTTPClient: mockHTTPClient}
+
+	client := Unauthenticated(config)
+	if _, err := client.GetBalance(context.Background(), "test-address"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if mockHTTPClient.GetRequestCount() != 1 {
+		t.Errorf("Expected request to go through configured HTTPClient, got %d requests", mockHTTPClient.GetRequestCount())
+	}
+
+	mockSigner := signers.NewMockSigner("test-address", turboTypes.TokenTypeArweave)
+	authClient := Authenticated(config, mockSigner)
+	if _, err := authClient.GetBalanceForSigner(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if mockHTTPClient.GetRequestCount() != 2 {
+		t.Errorf("Expected 2 requests through configured HTTPClient, got %d", mockHTTPClient.GetRequestCount())
+	}
+}
+
 func TestConfigImmutability(t *testing.T) {
 	// Test that default configs return new instances
 	config1 := DefaultConfig()
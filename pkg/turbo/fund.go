@@ -0,0 +1,128 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// ErrFundTransactionFailed is returned by WaitForFundTransaction when the payment service rejects a transaction
+var ErrFundTransactionFailed = errors.New("fund transaction failed")
+
+// paymentServiceInfo is the subset of the payment service info response used by the client
+type paymentServiceInfo struct {
+	Addresses map[types.TokenType]string `json:"addresses"`
+}
+
+// fundTransactionResponse holds exactly one of the transaction states reported by the payment service
+type fundTransactionResponse struct {
+	PendingTransaction  *types.FundTransaction `json:"pendingTransaction"`
+	CreditedTransaction *types.FundTransaction `json:"creditedTransaction"`
+	FailedTransaction   *types.FundTransaction `json:"failedTransaction"`
+}
+
+// GetTurboFundingAddresses returns the Turbo wallet address that accepts funding for each token type
+func (c *unauthenticatedClient) GetTurboFundingAddresses(ctx context.Context) (map[types.TokenType]string, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("%s/v1/info", c.httpClient.GetPaymentURL()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get funding addresses: %w", err)
+	}
+
+	var info paymentServiceInfo
+	if err := ParseJSON(resp, &info); err != nil {
+		return nil, fmt.Errorf("failed to get funding addresses: %w", err)
+	}
+
+	return info.Addresses, nil
+}
+
+// SubmitFundTransaction notifies the payment service of a transfer to a Turbo funding address.
+// Pending transactions are credited once confirmed; use WaitForFundTransaction to wait for it.
+func (c *unauthenticatedClient) SubmitFundTransaction(ctx context.Context, token types.TokenType, txID string) (*types.FundTransaction, error) {
+	if !token.IsValid() {
+		return nil, fmt.Errorf("unsupported token type: %s", token)
+	}
+	if txID == "" {
+		return nil, fmt.Errorf("transaction ID is required")
+	}
+
+	url := fmt.Sprintf("%s/v1/account/balance/%s", c.httpClient.GetPaymentURL(), token)
+	resp, err := c.postJSON(ctx, url, map[string]string{"tx_id": txID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit fund transaction %s: %w", txID, err)
+	}
+
+	var result fundTransactionResponse
+	if err := ParseJSON(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to submit fund transaction %s: %w", txID, err)
+	}
+
+	var tx *types.FundTransaction
+	switch {
+	case result.CreditedTransaction != nil:
+		tx = result.CreditedTransaction
+		tx.Status = types.FundTransactionConfirmed
+	case result.PendingTransaction != nil:
+		tx = result.PendingTransaction
+		tx.Status = types.FundTransactionPending
+	case result.FailedTransaction != nil:
+		tx = result.FailedTransaction
+		tx.Status = types.FundTransactionFailed
+	default:
+		return nil, fmt.Errorf("failed to submit fund transaction %s: response contains no transaction", txID)
+	}
+
+	if tx.ID == "" {
+		tx.ID = txID
+	}
+	if tx.TokenType == "" {
+		tx.TokenType = token
+	}
+
+	return tx, nil
+}
+
+// WaitForFundTransaction resubmits a fund transaction until it is no longer pending. A transaction
+// the payment service cannot see yet is treated as pending. A failed transaction is returned
+// along with an error wrapping ErrFundTransactionFailed.
+func (c *unauthenticatedClient) WaitForFundTransaction(ctx context.Context, token types.TokenType, txID string, pollPolicy *PollPolicy) (*types.FundTransaction, error) {
+	if pollPolicy == nil {
+		pollPolicy = DefaultPollPolicy()
+	}
+	if pollPolicy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, pollPolicy.Timeout)
+		defer cancel()
+	}
+
+	interval := pollPolicy.Interval
+	if interval <= 0 {
+		interval = DefaultPollPolicy().Interval
+	}
+
+	for {
+		tx, err := c.SubmitFundTransaction(ctx, token, txID)
+		switch {
+		case errors.Is(err, ErrNotFound):
+		case err != nil:
+			return nil, err
+		case tx.Status == types.FundTransactionFailed:
+			return tx, fmt.Errorf("transaction %s: %w", txID, ErrFundTransactionFailed)
+		case tx.Status == types.FundTransactionConfirmed:
+			return tx, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("timed out waiting for transaction %s to be credited: %w", txID, ctx.Err())
+		case <-timer.C:
+		}
+
+		interval = pollPolicy.next(interval)
+	}
+}
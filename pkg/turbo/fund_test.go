@@ -0,0 +1,147 @@
+package turbo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// fakePaymentService stands in for the crypto funding routes of the payment service.
+// Each submitted transaction walks through the configured states, one per submission.
+type fakePaymentService struct {
+	mu          sync.Mutex
+	states      []string
+	submissions int
+	lastBody    map[string]string
+	lastPath    string
+}
+
+func (f *fakePaymentService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/v1/info":
+		fmt.Fprint(w, `{"version":"0.2.0","addresses":{"arweave":"ar-wallet","ethereum":"0xeth-wallet","solana":"sol-wallet"}}`)
+	case r.Method == "POST":
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		json.NewDecoder(r.Body).Decode(&f.lastBody)
+		f.lastPath = r.URL.Path
+
+		state := f.states[f.submissions]
+		if f.submissions < len(f.states)-1 {
+			f.submissions++
+		}
+		if state == "notFound" {
+			http.Error(w, "Transaction not found", http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprintf(w, `{"%s":{"transactionId":"%s","transactionQuantity":"1000000","tokenType":"ethereum","winstonCreditAmount":"42","destinationAddress":"0xowner","destinationAddressType":"ethereum"}}`,
+			state, f.lastBody["tx_id"])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestGetTurboFundingAddresses(t *testing.T) {
+	server := httptest.NewServer(&fakePaymentService{})
+	defer server.Close()
+
+	client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+	addresses, err := client.GetTurboFundingAddresses(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if addresses[types.TokenTypeEthereum] != "0xeth-wallet" || addresses[types.TokenTypeArweave] != "ar-wallet" {
+		t.Errorf("Unexpected funding addresses: %v", addresses)
+	}
+}
+
+func TestSubmitFundTransaction(t *testing.T) {
+	testCases := []struct {
+		state    string
+		expected types.FundTransactionStatus
+	}{
+		{"pendingTransaction", types.FundTransactionPending},
+		{"creditedTransaction", types.FundTransactionConfirmed},
+		{"failedTransaction", types.FundTransactionFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.state, func(t *testing.T) {
+			service := &fakePaymentService{states: []string{tc.state}}
+			server := httptest.NewServer(service)
+			defer server.Close()
+
+			client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+			tx, err := client.SubmitFundTransaction(context.Background(), types.TokenTypeEthereum, "0xtx")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if tx.Status != tc.expected {
+				t.Errorf("Expected status %s, got %s", tc.expected, tx.Status)
+			}
+			if tx.ID != "0xtx" || tx.WinC.String() != "42" || tx.Quantity != "1000000" {
+				t.Errorf("Unexpected transaction: %+v", tx)
+			}
+			if service.lastPath != "/v1/account/balance/ethereum" || service.lastBody["tx_id"] != "0xtx" {
+				t.Errorf("Unexpected request %s %v", service.lastPath, service.lastBody)
+			}
+		})
+	}
+}
+
+func TestSubmitFundTransactionValidation(t *testing.T) {
+	client := NewUnauthenticatedClientForTesting(NewMockHTTPClient())
+
+	if _, err := client.SubmitFundTransaction(context.Background(), "dogecoin", "tx"); err == nil {
+		t.Error("Expected error for unsupported token type")
+	}
+	if _, err := client.SubmitFundTransaction(context.Background(), types.TokenTypeArweave, ""); err == nil {
+		t.Error("Expected error for missing transaction ID")
+	}
+}
+
+func TestWaitForFundTransaction(t *testing.T) {
+	service := &fakePaymentService{states: []string{"notFound", "pendingTransaction", "creditedTransaction"}}
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+	tx, err := client.WaitForFundTransaction(context.Background(), types.TokenTypeEthereum, "0xtx", &PollPolicy{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if tx.Status != types.FundTransactionConfirmed || tx.WinC.String() != "42" {
+		t.Errorf("Expected credited transaction, got %+v", tx)
+	}
+	if service.submissions != 2 {
+		t.Errorf("Expected to reach the final state, got %d submissions", service.submissions)
+	}
+}
+
+func TestWaitForFundTransactionFailed(t *testing.T) {
+	server := httptest.NewServer(&fakePaymentService{states: []string{"pendingTransaction", "failedTransaction"}})
+	defer server.Close()
+
+	client := Unauthenticated(&TurboConfig{PaymentURL: server.URL, UploadURL: server.URL})
+	tx, err := client.WaitForFundTransaction(context.Background(), types.TokenTypeEthereum, "0xtx", &PollPolicy{Interval: time.Millisecond})
+
+	if !errors.Is(err, ErrFundTransactionFailed) {
+		t.Errorf("Expected ErrFundTransactionFailed, got %v", err)
+	}
+	if tx == nil || tx.Status != types.FundTransactionFailed {
+		t.Errorf("Expected failed transaction, got %+v", tx)
+	}
+}
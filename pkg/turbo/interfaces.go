@@ -2,6 +2,7 @@ package turbo
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
 	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
@@ -15,8 +16,53 @@ type TurboUnauthenticatedClient interface {
 	// GetUploadCosts returns the estimated cost in Winston Credits for the provided file sizes
 	GetUploadCosts(ctx context.Context, bytes []int64) ([]types.UploadCost, error)
 
+	// GetFiatRates returns the price of one GiB of uploads in winc and in each supported fiat currency
+	GetFiatRates(ctx context.Context) (*types.FiatRates, error)
+
+	// GetFiatToAR returns the price of one AR in the given fiat currency
+	GetFiatToAR(ctx context.Context, currency string) (*types.FiatToAR, error)
+
+	// GetWincForFiat returns the winc a fiat payment, given in the currency's smallest unit, would buy
+	GetWincForFiat(ctx context.Context, amount int64, currency string, promoCodes []string) (*types.WincForFiat, error)
+
+	// GetWincForToken returns the winc a native token payment, given in the token's base unit, would buy
+	GetWincForToken(ctx context.Context, token types.TokenType, amount *big.Int) (*types.WincForToken, error)
+
+	// GetSupportedCurrencies returns the fiat currencies accepted for top-ups and their payment limits
+	GetSupportedCurrencies(ctx context.Context) (*types.SupportedCurrencies, error)
+
+	// GetSupportedCountries returns the countries accepted for fiat top-ups
+	GetSupportedCountries(ctx context.Context) ([]string, error)
+
 	// UploadSignedDataItem uploads a pre-signed data item
 	UploadSignedDataItem(ctx context.Context, req *types.SignedDataItemUploadRequest) (*types.UploadResult, error)
+
+	// UploadSignedDataItemChunked uploads a pre-signed data item in chunks, optionally resuming an existing session
+	UploadSignedDataItemChunked(ctx context.Context, req *types.ChunkedUploadRequest) (*types.UploadResult, error)
+
+	// GetUploadStatus returns the current status of an uploaded data item
+	GetUploadStatus(ctx context.Context, id string) (*types.UploadStatus, error)
+
+	// WaitForStatus polls the status of a data item until it reaches the target status
+	WaitForStatus(ctx context.Context, id string, target types.UploadStatusCode, pollPolicy *PollPolicy) (*types.UploadStatus, error)
+
+	// CreateCheckoutSession creates a fiat top-up checkout session that credits the owner address
+	CreateCheckoutSession(ctx context.Context, owner string, amount int64, currency string, opts *types.CheckoutSessionOptions) (*types.CheckoutSession, error)
+
+	// CreateGiftCheckoutSession creates a checkout session for credits gifted to an email address
+	CreateGiftCheckoutSession(ctx context.Context, recipientEmail string, amount int64, currency, message string) (*types.CheckoutSession, error)
+
+	// RedeemGift redeems gifted credits into a wallet
+	RedeemGift(ctx context.Context, code, email, destinationAddress string) (*types.GiftRedemption, error)
+
+	// GetTurboFundingAddresses returns the Turbo wallet address that accepts funding for each token type
+	GetTurboFundingAddresses(ctx context.Context) (map[types.TokenType]string, error)
+
+	// SubmitFundTransaction submits a transfer to a Turbo funding address for crediting
+	SubmitFundTransaction(ctx context.Context, token types.TokenType, txID string) (*types.FundTransaction, error)
+
+	// WaitForFundTransaction resubmits a fund transaction until it is credited or fails
+	WaitForFundTransaction(ctx context.Context, token types.TokenType, txID string, pollPolicy *PollPolicy) (*types.FundTransaction, error)
 }
 
 // TurboAuthenticatedClient provides access to both authenticated and unauthenticated Turbo services
@@ -29,6 +75,15 @@ type TurboAuthenticatedClient interface {
 	// Upload signs and uploads data to Turbo
 	Upload(ctx context.Context, req *types.UploadRequest) (*types.UploadResult, error)
 
+	// ShareCredits approves another address to spend up to winc of the signer's credits
+	ShareCredits(ctx context.Context, approvedAddress string, winc types.Winc, expiresBySeconds int64) (*types.CreditShareApproval, error)
+
+	// RevokeCredits revokes the credit share approvals given to an address
+	RevokeCredits(ctx context.Context, revokedAddress string) ([]types.CreditShareApproval, error)
+
+	// ListShares returns the credit share approvals the signer has given and received
+	ListShares(ctx context.Context) (*types.CreditShareApprovals, error)
+
 	// GetSigner returns the signer associated with this client
 	GetSigner() signers.Signer
 }
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 )
 
 // MockHTTPClient implements HTTPClient for testing
@@ -15,6 +16,8 @@ type MockHTTPClient struct {
 	UploadURL      string
 	Responses      map[string]*http.Response
 	RequestHistory []MockRequest
+
+	mu sync.Mutex
 }
 
 // MockRequest tracks requests made to the mock client
@@ -36,24 +39,25 @@ func NewMockHTTPClient() *MockHTTPClient {
 }
 
 func (m *MockHTTPClient) Get(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
-	m.RequestHistory = append(m.RequestHistory, MockRequest{
+	m.record(MockRequest{
 		Method:  "GET",
 		URL:     url,
 		Headers: headers,
 	})
 
 	if m.GetFunc != nil {
-		return m.GetFunc(ctx, url, headers)
+		resp, err := m.GetFunc(ctx, url, headers)
+		return attachRequest(resp, err, "GET", url)
 	}
 
-	if resp, exists := m.Responses[url]; exists {
-		return resp, nil
+	if resp, exists := m.response(url); exists {
+		return attachRequest(resp, nil, "GET", url)
 	}
 
-	return &http.Response{
+	return attachRequest(&http.Response{
 		StatusCode: 200,
 		Body:       io.NopCloser(strings.NewReader(`{"status":"ok"}`)),
-	}, nil
+	}, nil, "GET", url)
 }
 
 func (m *MockHTTPClient) Post(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
@@ -62,7 +66,7 @@ func (m *MockHTTPClient) Post(ctx context.Context, url string, body io.Reader, h
 		bodyBytes, _ = io.ReadAll(body)
 	}
 
-	m.RequestHistory = append(m.RequestHistory, MockRequest{
+	m.record(MockRequest{
 		Method:  "POST",
 		URL:     url,
 		Headers: headers,
@@ -70,17 +74,18 @@ func (m *MockHTTPClient) Post(ctx context.Context, url string, body io.Reader, h
 	})
 
 	if m.PostFunc != nil {
-		return m.PostFunc(ctx, url, strings.NewReader(string(bodyBytes)), headers)
+		resp, err := m.PostFunc(ctx, url, strings.NewReader(string(bodyBytes)), headers)
+		return attachRequest(resp, err, "POST", url)
 	}
 
-	if resp, exists := m.Responses[url]; exists {
-		return resp, nil
+	if resp, exists := m.response(url); exists {
+		return attachRequest(resp, nil, "POST", url)
 	}
 
-	return &http.Response{
+	return attachRequest(&http.Response{
 		StatusCode: 200,
 		Body:       io.NopCloser(strings.NewReader(`{"id":"test-upload-id","owner":"test-owner"}`)),
-	}, nil
+	}, nil, "POST", url)
 }
 
 func (m *MockHTTPClient) GetPaymentURL() string {
@@ -91,13 +96,40 @@ func (m *MockHTTPClient) GetUploadURL() string {
 	return m.UploadURL
 }
 
+// attachRequest records the originating request on a mock response, as net/http does
+func attachRequest(resp *http.Response, err error, method, url string) (*http.Response, error) {
+	if resp != nil && resp.Request == nil {
+		resp.Request, _ = http.NewRequest(method, url, nil)
+	}
+	return resp, err
+}
+
+// record appends a request to the history; the mock may be used concurrently
+func (m *MockHTTPClient) record(request MockRequest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RequestHistory = append(m.RequestHistory, request)
+}
+
+// response looks up the mock response registered for a URL
+func (m *MockHTTPClient) response(url string) (*http.Response, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	resp, exists := m.Responses[url]
+	return resp, exists
+}
+
 // SetResponse sets a mock response for a specific URL
 func (m *MockHTTPClient) SetResponse(url string, response *http.Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.Responses[url] = response
 }
 
 // GetLastRequest returns the last request made to the mock client
 func (m *MockHTTPClient) GetLastRequest() *MockRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	if len(m.RequestHistory) == 0 {
 		return nil
 	}
@@ -106,10 +138,14 @@ func (m *MockHTTPClient) GetLastRequest() *MockRequest {
 
 // GetRequestCount returns the number of requests made
 func (m *MockHTTPClient) GetRequestCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	return len(m.RequestHistory)
 }
 
 // ClearHistory clears the request history
 func (m *MockHTTPClient) ClearHistory() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.RequestHistory = make([]MockRequest, 0)
 }
@@ -0,0 +1,189 @@
+package turbo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// DefaultPriceConcurrency is the number of byte counts priced in parallel by default
+const DefaultPriceConcurrency = 8
+
+// PriceCache holds upload quotes for a limited time, keyed on byte count and token.
+// A single cache may be shared by several clients. The zero value is not usable;
+// create one with NewPriceCache.
+type PriceCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[priceCacheKey]priceCacheEntry
+}
+
+type priceCacheKey struct {
+	token string
+	bytes int64
+}
+
+type priceCacheEntry struct {
+	cost    types.UploadCost
+	expires time.Time
+}
+
+// NewPriceCache creates a price cache whose quotes expire after ttl
+func NewPriceCache(ttl time.Duration) *PriceCache {
+	return &PriceCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[priceCacheKey]priceCacheEntry),
+	}
+}
+
+// Invalidate discards every cached quote, e.g. after the service's pricing has changed
+func (p *PriceCache) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries = make(map[priceCacheKey]priceCacheEntry)
+}
+
+// get returns an unexpired quote; a nil cache never hits
+func (p *PriceCache) get(token string, bytes int64) (types.UploadCost, bool) {
+	if p == nil {
+		return types.UploadCost{}, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := priceCacheKey{token: token, bytes: bytes}
+	entry, ok := p.entries[key]
+	if !ok {
+		return types.UploadCost{}, false
+	}
+	if !p.now().Before(entry.expires) {
+		delete(p.entries, key)
+		return types.UploadCost{}, false
+	}
+	return cloneUploadCost(entry.cost), true
+}
+
+// put stores a quote; a nil cache discards it
+func (p *PriceCache) put(token string, cost types.UploadCost) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.entries[priceCacheKey{token: token, bytes: cost.Bytes}] = priceCacheEntry{
+		cost:    cloneUploadCost(cost),
+		expires: p.now().Add(p.ttl),
+	}
+}
+
+// cloneUploadCost copies the adjustment and fee slices so cached quotes cannot be modified by callers
+func cloneUploadCost(cost types.UploadCost) types.UploadCost {
+	if cost.Adjustments != nil {
+		cost.Adjustments = append([]types.Adjustment(nil), cost.Adjustments...)
+	}
+	if cost.Fees != nil {
+		cost.Fees = append([]types.Adjustment(nil), cost.Fees...)
+	}
+	return cost
+}
+
+// GetUploadCosts returns the estimated cost in Winston Credits for the provided file sizes.
+// Byte counts are priced with bounded concurrency (one request each, matching the TypeScript
+// implementation), and the costs are returned in the order of bytes. Quotes held by the
+// client's price cache are returned without a request.
+func (c *unauthenticatedClient) GetUploadCosts(ctx context.Context, bytes []int64) ([]types.UploadCost, error) {
+	concurrency := c.priceConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultPriceConcurrency
+	}
+
+	costs := make([]types.UploadCost, len(bytes))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	slots := make(chan struct{}, concurrency)
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	for i, byteCount := range bytes {
+		if cost, ok := c.priceCache.get(c.token, byteCount); ok {
+			costs[i] = cost
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, byteCount int64) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			cost, err := c.getUploadCost(ctx, byteCount)
+			if err != nil {
+				fail(err)
+				return
+			}
+			costs[i] = *cost
+		}(i, byteCount)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return costs, nil
+}
+
+// getUploadCost prices a single byte count and caches the quote
+func (c *unauthenticatedClient) getUploadCost(ctx context.Context, byteCount int64) (*types.UploadCost, error) {
+	url := fmt.Sprintf("%s/v1/price/bytes/%d", c.httpClient.GetPaymentURL(), byteCount)
+	resp, err := c.get(ctx, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload cost for byte count %d: %w", byteCount, err)
+	}
+
+	var cost types.UploadCost
+	if err := ParseJSON(resp, &cost); err != nil {
+		return nil, fmt.Errorf("failed to parse response for byte count %d: %w", byteCount, err)
+	}
+
+	// Set the byte count since the API doesn't return it
+	cost.Bytes = byteCount
+
+	c.priceCache.put(c.token, cost)
+
+	return &cost, nil
+}
@@ -0,0 +1,199 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// priceMockClient returns a mock that prices every byte count at twice its size
+func priceMockClient() *MockHTTPClient {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		byteCount, err := strconv.ParseInt(url[strings.LastIndex(url, "/")+1:], 10, 64)
+		if err != nil {
+			return jsonResponse(400, "bad byte count"), nil
+		}
+		return jsonResponse(200, fmt.Sprintf(`{"winc":"%d","adjustments":[]}`, byteCount*2)), nil
+	}
+	return mockClient
+}
+
+func TestGetUploadCostsKeepsOrder(t *testing.T) {
+	mockClient := priceMockClient()
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, PriceConcurrency: 4})
+
+	bytes := make([]int64, 50)
+	for i := range bytes {
+		bytes[i] = int64(len(bytes)-i) * 1024
+	}
+
+	costs, err := client.GetUploadCosts(context.Background(), bytes)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for i, cost := range costs {
+		if cost.Bytes != bytes[i] {
+			t.Errorf("Expected cost %d for %d bytes, got %d", i, bytes[i], cost.Bytes)
+		}
+		if cost.Winc.Cmp(types.NewWinc(bytes[i]*2)) != 0 {
+			t.Errorf("Expected cost %d to be %d winc, got %s", i, bytes[i]*2, cost.Winc)
+		}
+	}
+
+	if mockClient.GetRequestCount() != len(bytes) {
+		t.Errorf("Expected %d requests, got %d", len(bytes), mockClient.GetRequestCount())
+	}
+}
+
+func TestGetUploadCostsBoundsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return jsonResponse(200, `{"winc":"1"}`), nil
+	}
+
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, PriceConcurrency: 3})
+	if _, err := client.GetUploadCosts(context.Background(), []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if maxInFlight > 3 {
+		t.Errorf("Expected at most 3 concurrent requests, got %d", maxInFlight)
+	}
+	if maxInFlight < 2 {
+		t.Errorf("Expected requests to run concurrently, got %d at a time", maxInFlight)
+	}
+}
+
+func TestGetUploadCostsReturnsFirstError(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		if strings.HasSuffix(url, "/3") {
+			return jsonResponse(404, "Not Found"), nil
+		}
+		return jsonResponse(200, `{"winc":"1"}`), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	costs, err := client.GetUploadCosts(context.Background(), []int64{1, 2, 3, 4})
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if costs != nil {
+		t.Errorf("Expected no costs, got %v", costs)
+	}
+}
+
+func TestPriceCacheServesRepeatedQuotes(t *testing.T) {
+	mockClient := priceMockClient()
+	cache := NewPriceCache(time.Minute)
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, PriceCache: cache})
+
+	ctx := context.Background()
+	if _, err := client.GetUploadCosts(ctx, []int64{1024, 2048}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	costs, err := client.GetUploadCosts(ctx, []int64{2048, 4096, 1024})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if mockClient.GetRequestCount() != 3 {
+		t.Errorf("Expected only the uncached size to be priced, got %d requests", mockClient.GetRequestCount())
+	}
+	for i, expected := range []int64{2048, 4096, 1024} {
+		if costs[i].Bytes != expected || costs[i].Winc.Cmp(types.NewWinc(expected*2)) != 0 {
+			t.Errorf("Expected cost %d for %d bytes, got %+v", i, expected, costs[i])
+		}
+	}
+}
+
+func TestPriceCacheExpiresAndInvalidates(t *testing.T) {
+	mockClient := priceMockClient()
+	cache := NewPriceCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, PriceCache: cache})
+
+	ctx := context.Background()
+	quote := func() {
+		t.Helper()
+		if _, err := client.GetUploadCosts(ctx, []int64{1024}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	quote()
+	now = now.Add(59 * time.Second)
+	quote()
+	if mockClient.GetRequestCount() != 1 {
+		t.Errorf("Expected a cached quote within the TTL, got %d requests", mockClient.GetRequestCount())
+	}
+
+	now = now.Add(time.Second)
+	quote()
+	if mockClient.GetRequestCount() != 2 {
+		t.Errorf("Expected an expired quote to be refetched, got %d requests", mockClient.GetRequestCount())
+	}
+
+	cache.Invalidate()
+	quote()
+	if mockClient.GetRequestCount() != 3 {
+		t.Errorf("Expected an invalidated quote to be refetched, got %d requests", mockClient.GetRequestCount())
+	}
+}
+
+func TestPriceCacheKeysOnToken(t *testing.T) {
+	mockClient := priceMockClient()
+	cache := NewPriceCache(time.Minute)
+
+	ctx := context.Background()
+	for _, token := range []types.TokenType{types.TokenTypeArweave, types.TokenTypeEthereum, types.TokenTypeArweave} {
+		client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, PriceCache: cache, Token: token})
+		if _, err := client.GetUploadCosts(ctx, []int64{1024}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	if mockClient.GetRequestCount() != 2 {
+		t.Errorf("Expected one request per token, got %d", mockClient.GetRequestCount())
+	}
+}
+
+func TestPriceCacheConcurrentUse(t *testing.T) {
+	client := Unauthenticated(&TurboConfig{HTTPClient: priceMockClient(), PriceCache: NewPriceCache(time.Minute)})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetUploadCosts(context.Background(), []int64{1, 2, 3, 4}); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,75 @@
+package turbo
+
+import (
+	"io"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// progressReader counts bytes as they are read and emits throttled progress events
+type progressReader struct {
+	io.ReadCloser
+	events    *types.UploadEvents
+	step      types.ProgressStep
+	total     int64
+	processed int64
+
+	lastBytes int64
+	lastTime  time.Time
+}
+
+// newProgressReader wraps r so that reads are reported through events.OnProgress.
+// The event for the final byte is always emitted; intermediate events are throttled
+// by the byte and time intervals configured on events.
+func newProgressReader(r io.ReadCloser, total int64, step types.ProgressStep, events *types.UploadEvents) io.ReadCloser {
+	if events == nil || events.OnProgress == nil {
+		return r
+	}
+	return &progressReader{
+		ReadCloser: r,
+		events:     events,
+		step:       step,
+		total:      total,
+		lastTime:   time.Now(),
+	}
+}
+
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.ReadCloser.Read(buf)
+	if n > 0 {
+		p.processed += int64(n)
+		if p.shouldEmit() {
+			p.emit()
+		}
+	}
+	return n, err
+}
+
+// shouldEmit applies the configured throttling; the final byte is always reported
+func (p *progressReader) shouldEmit() bool {
+	if p.processed >= p.total {
+		return true
+	}
+
+	byteInterval := p.events.ProgressByteInterval
+	timeInterval := p.events.ProgressTimeInterval
+	if byteInterval <= 0 && timeInterval <= 0 {
+		return true
+	}
+
+	if byteInterval > 0 && p.processed-p.lastBytes >= byteInterval {
+		return true
+	}
+	return timeInterval > 0 && time.Since(p.lastTime) >= timeInterval
+}
+
+func (p *progressReader) emit() {
+	p.lastBytes = p.processed
+	p.lastTime = time.Now()
+	p.events.OnProgress(types.ProgressEvent{
+		TotalBytes:     p.total,
+		ProcessedBytes: p.processed,
+		Step:           p.step,
+	})
+}
@@ -0,0 +1,102 @@
+package turbo
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// readInChunks drains r using reads of the given size
+func readInChunks(t *testing.T, r io.Reader, size int) {
+	t.Helper()
+	buf := make([]byte, size)
+	for {
+		_, err := r.Read(buf)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatalf("Unexpected read error: %v", err)
+		}
+	}
+}
+
+func TestProgressReaderEmitsEveryRead(t *testing.T) {
+	var events []types.ProgressEvent
+	uploadEvents := &types.UploadEvents{
+		OnProgress: func(event types.ProgressEvent) {
+			events = append(events, event)
+		},
+	}
+
+	reader := newProgressReader(io.NopCloser(bytes.NewReader(make([]byte, 100))), 100, types.StepUploading, uploadEvents)
+	readInChunks(t, reader, 10)
+
+	if len(events) != 10 {
+		t.Fatalf("Expected 10 progress events, got %d", len(events))
+	}
+
+	for i, event := range events {
+		if event.ProcessedBytes != int64((i+1)*10) || event.TotalBytes != 100 || event.Step != types.StepUploading {
+			t.Errorf("Unexpected event %d: %+v", i, event)
+		}
+	}
+}
+
+func TestProgressReaderThrottlesByBytes(t *testing.T) {
+	var events []types.ProgressEvent
+	uploadEvents := &types.UploadEvents{
+		OnProgress: func(event types.ProgressEvent) {
+			events = append(events, event)
+		},
+		ProgressByteInterval: 35,
+	}
+
+	reader := newProgressReader(io.NopCloser(bytes.NewReader(make([]byte, 100))), 100, types.StepSigning, uploadEvents)
+	readInChunks(t, reader, 10)
+
+	// Emitted at 40 and 80 bytes, plus the final byte
+	expected := []int64{40, 80, 100}
+	if len(events) != len(expected) {
+		t.Fatalf("Expected %d progress events, got %d", len(expected), len(events))
+	}
+
+	for i, processed := range expected {
+		if events[i].ProcessedBytes != processed {
+			t.Errorf("Expected event %d at %d bytes, got %d", i, processed, events[i].ProcessedBytes)
+		}
+	}
+}
+
+func TestProgressReaderThrottlesByInterval(t *testing.T) {
+	var events []types.ProgressEvent
+	uploadEvents := &types.UploadEvents{
+		OnProgress: func(event types.ProgressEvent) {
+			events = append(events, event)
+		},
+		ProgressTimeInterval: time.Hour,
+	}
+
+	reader := newProgressReader(io.NopCloser(bytes.NewReader(make([]byte, 100))), 100, types.StepUploading, uploadEvents)
+	readInChunks(t, reader, 10)
+
+	// Only the completion event passes an hour-long interval
+	if len(events) != 1 || events[0].ProcessedBytes != 100 {
+		t.Errorf("Expected a single completion event, got %+v", events)
+	}
+}
+
+func TestProgressReaderWithoutCallback(t *testing.T) {
+	source := io.NopCloser(bytes.NewReader([]byte("data")))
+
+	if reader := newProgressReader(source, 4, types.StepUploading, nil); reader != source {
+		t.Error("Expected reader to be returned unwrapped without events")
+	}
+
+	if reader := newProgressReader(source, 4, types.StepUploading, &types.UploadEvents{}); reader != source {
+		t.Error("Expected reader to be returned unwrapped without OnProgress")
+	}
+}
@@ -0,0 +1,132 @@
+package turbo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// GetFiatRates returns the price of one GiB of uploads in winc and in each supported fiat currency
+func (c *unauthenticatedClient) GetFiatRates(ctx context.Context) (*types.FiatRates, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("%s/v1/rates", c.httpClient.GetPaymentURL()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fiat rates: %w", err)
+	}
+
+	var rates types.FiatRates
+	if err := ParseJSON(resp, &rates); err != nil {
+		return nil, fmt.Errorf("failed to get fiat rates: %w", err)
+	}
+
+	return &rates, nil
+}
+
+// GetFiatToAR returns the price of one AR in the given fiat currency
+func (c *unauthenticatedClient) GetFiatToAR(ctx context.Context, currency string) (*types.FiatToAR, error) {
+	if currency == "" {
+		return nil, fmt.Errorf("currency is required")
+	}
+
+	url := fmt.Sprintf("%s/v1/rates/%s", c.httpClient.GetPaymentURL(), strings.ToLower(currency))
+	resp, err := c.get(ctx, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s to AR rate: %w", currency, err)
+	}
+
+	var rate types.FiatToAR
+	if err := ParseJSON(resp, &rate); err != nil {
+		return nil, fmt.Errorf("failed to get %s to AR rate: %w", currency, err)
+	}
+
+	return &rate, nil
+}
+
+// GetWincForFiat returns the winc a fiat payment would buy. amount is given in the smallest
+// unit of currency (e.g. cents for "usd").
+func (c *unauthenticatedClient) GetWincForFiat(ctx context.Context, amount int64, currency string, promoCodes []string) (*types.WincForFiat, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if currency == "" {
+		return nil, fmt.Errorf("currency is required")
+	}
+
+	priceURL := fmt.Sprintf("%s/v1/price/%s/%d", c.httpClient.GetPaymentURL(), strings.ToLower(currency), amount)
+	if len(promoCodes) > 0 {
+		query := url.Values{}
+		for _, code := range promoCodes {
+			query.Add("promoCode", code)
+		}
+		priceURL += "?" + query.Encode()
+	}
+
+	resp, err := c.get(ctx, priceURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winc for %d %s: %w", amount, currency, err)
+	}
+
+	var quote types.WincForFiat
+	if err := ParseJSON(resp, &quote); err != nil {
+		return nil, fmt.Errorf("failed to get winc for %d %s: %w", amount, currency, err)
+	}
+
+	return &quote, nil
+}
+
+// GetWincForToken returns the winc a native token payment would buy. amount is given in the
+// token's base unit (e.g. winston for arweave, wei for ethereum, lamports for solana).
+func (c *unauthenticatedClient) GetWincForToken(ctx context.Context, token types.TokenType, amount *big.Int) (*types.WincForToken, error) {
+	if !token.IsValid() {
+		return nil, fmt.Errorf("unsupported token type: %s", token)
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	url := fmt.Sprintf("%s/v1/price/%s/%s", c.httpClient.GetPaymentURL(), token, amount.String())
+	resp, err := c.get(ctx, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winc for %s %s: %w", amount, token, err)
+	}
+
+	var quote types.WincForToken
+	if err := ParseJSON(resp, &quote); err != nil {
+		return nil, fmt.Errorf("failed to get winc for %s %s: %w", amount, token, err)
+	}
+
+	return &quote, nil
+}
+
+// GetSupportedCurrencies returns the fiat currencies accepted for top-ups along with their payment limits
+func (c *unauthenticatedClient) GetSupportedCurrencies(ctx context.Context) (*types.SupportedCurrencies, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("%s/v1/currencies", c.httpClient.GetPaymentURL()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supported currencies: %w", err)
+	}
+
+	var currencies types.SupportedCurrencies
+	if err := ParseJSON(resp, &currencies); err != nil {
+		return nil, fmt.Errorf("failed to get supported currencies: %w", err)
+	}
+
+	return &currencies, nil
+}
+
+// GetSupportedCountries returns the countries accepted for fiat top-ups
+func (c *unauthenticatedClient) GetSupportedCountries(ctx context.Context) ([]string, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("%s/v1/countries", c.httpClient.GetPaymentURL()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supported countries: %w", err)
+	}
+
+	var countries []string
+	if err := ParseJSON(resp, &countries); err != nil {
+		return nil, fmt.Errorf("failed to get supported countries: %w", err)
+	}
+
+	return countries, nil
+}
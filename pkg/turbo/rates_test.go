@@ -0,0 +1,131 @@
+package turbo
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func TestGetFiatRates(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/rates", jsonResponse(200,
+		`{"winc":"857922282166","fiat":{"usd":18.11,"eur":16.72},
+		"adjustments":[{"name":"FWD Research","description":"Storage subsidy","operator":"multiply","operatorMagnitude":0.4,"adjustmentAmount":"-514753369300"}],
+		"fees":[{"name":"Turbo Infra Fee","description":"Infrastructure fee","operator":"multiply","operatorMagnitude":0.766,"adjustmentAmount":"200752814026"}]}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	rates, err := client.GetFiatRates(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if rates.WinC.String() != "857922282166" || rates.Fiat["usd"] != 18.11 || rates.Fiat["eur"] != 16.72 {
+		t.Errorf("Unexpected rates: %+v", rates)
+	}
+	if len(rates.Adjustments) != 1 || rates.Adjustments[0].AdjustmentAmount.String() != "-514753369300" {
+		t.Errorf("Unexpected adjustments: %+v", rates.Adjustments)
+	}
+	if len(rates.Fees) != 1 || rates.Fees[0].Name != "Turbo Infra Fee" || rates.Fees[0].AdjustmentAmount.String() != "200752814026" {
+		t.Errorf("Unexpected fees: %+v", rates.Fees)
+	}
+}
+
+func TestGetFiatToAR(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/rates/usd", jsonResponse(200, `{"currency":"usd","rate":21.5}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	rate, err := client.GetFiatToAR(context.Background(), "USD")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if rate.Currency != "usd" || rate.Rate != 21.5 {
+		t.Errorf("Unexpected rate: %+v", rate)
+	}
+}
+
+func TestGetWincForFiat(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/price/usd/1000?promoCode=A&promoCode=B", jsonResponse(200, `{
+		"winc": "500000000000",
+		"actualPaymentAmount": 800,
+		"quotedPaymentAmount": 1000,
+		"adjustments": [{"name":"Promo","description":"20% off","operator":"multiply","operatorMagnitude":0.8,"adjustmentAmount":-200}],
+		"fees": [{"name":"Payment processing","description":"Card fee","operator":"add","operatorMagnitude":30,"adjustmentAmount":"30"}]
+	}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	quote, err := client.GetWincForFiat(context.Background(), 1000, "usd", []string{"A", "B"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if quote.WinC.String() != "500000000000" || quote.ActualPaymentAmount != 800 || quote.QuotedPaymentAmount != 1000 {
+		t.Errorf("Unexpected quote: %+v", quote)
+	}
+	if len(quote.Adjustments) != 1 || quote.Adjustments[0].Operator != "multiply" || quote.Adjustments[0].AdjustmentAmount.String() != "-200" {
+		t.Errorf("Unexpected adjustments: %+v", quote.Adjustments)
+	}
+	if len(quote.Fees) != 1 || quote.Fees[0].AdjustmentAmount.String() != "30" {
+		t.Errorf("Unexpected fees: %+v", quote.Fees)
+	}
+}
+
+func TestGetWincForToken(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/price/ethereum/1000000000000000000", jsonResponse(200,
+		`{"winc":"1234","actualTokenAmount":"1000000000000000000","equivalentWincTokenAmount":"1300",
+		"adjustments":[{"name":"Promo","description":"Bonus credits","operator":"multiply","operatorMagnitude":1.1,"adjustmentAmount":"100"}],
+		"fees":[{"name":"Turbo Infra Fee","description":"Infrastructure fee","operator":"multiply","operatorMagnitude":0.766,"adjustmentAmount":"-166"}]}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	amount, _ := new(big.Int).SetString("1000000000000000000", 10)
+	quote, err := client.GetWincForToken(context.Background(), types.TokenTypeEthereum, amount)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if quote.WinC.String() != "1234" || quote.EquivalentWincTokenAmount.String() != "1300" {
+		t.Errorf("Unexpected quote: %+v", quote)
+	}
+	if len(quote.Adjustments) != 1 || quote.Adjustments[0].AdjustmentAmount.String() != "100" {
+		t.Errorf("Unexpected adjustments: %+v", quote.Adjustments)
+	}
+	if len(quote.Fees) != 1 || quote.Fees[0].AdjustmentAmount.String() != "-166" {
+		t.Errorf("Unexpected fees: %+v", quote.Fees)
+	}
+
+	if _, err := client.GetWincForToken(context.Background(), types.TokenTypeEthereum, big.NewInt(0)); err == nil {
+		t.Error("Expected error for zero amount")
+	}
+}
+
+func TestGetSupportedCurrenciesAndCountries(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/currencies", jsonResponse(200, `{
+		"supportedCurrencies": ["usd", "jpy"],
+		"limits": {"jpy": {"minimumPaymentAmount": 750, "maximumPaymentAmount": 1500000, "suggestedPaymentAmounts": [1500, 3000], "zeroDecimalCurrency": true}}
+	}`))
+	mockClient.SetResponse("https://mock-payment.test/v1/countries", jsonResponse(200, `["United States","Japan"]`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	currencies, err := client.GetSupportedCurrencies(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(currencies.SupportedCurrencies) != 2 || !currencies.Limits["jpy"].ZeroDecimalCurrency || currencies.Limits["jpy"].MinimumPaymentAmount != 750 {
+		t.Errorf("Unexpected currencies: %+v", currencies)
+	}
+
+	countries, err := client.GetSupportedCountries(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(countries) != 2 || countries[1] != "Japan" {
+		t.Errorf("Unexpected countries: %v", countries)
+	}
+}
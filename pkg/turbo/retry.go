@@ -0,0 +1,161 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// RetryPolicy controls how failed requests to Turbo services are retried
+type RetryPolicy struct {
+	MaxAttempts       int           // Total attempts including the first; values below 2 disable retries
+	BaseDelay         time.Duration // Delay before the first retry, doubled on each subsequent retry
+	MaxDelay          time.Duration // Upper bound for backoff delays, zero for none; a longer Retry-After ends retrying
+	Jitter            float64       // Fraction (0-1) of each delay that is randomized
+	RetryableStatuses []int         // HTTP status codes that trigger a retry
+}
+
+// DefaultRetryPolicy returns the retry policy used by DefaultConfig and DevConfig
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// permanentError marks an error that must not be retried
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// isRetryableStatus reports whether the status code is listed in the policy
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	for _, status := range p.RetryableStatuses {
+		if status == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1-based)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay <= math.MaxInt64/2; i++ {
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		jitter := p.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		delay -= time.Duration(rand.Float64() * jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// doWithRetry calls send until it succeeds, fails permanently or the policy is exhausted.
+// A response with a retryable status is returned as-is on the final attempt, or when its
+// Retry-After exceeds the policy's MaxDelay, so callers can surface the service error.
+func doWithRetry(ctx context.Context, policy *RetryPolicy, step types.ProgressStep, events *types.UploadEvents, send func() (*http.Response, error)) (*http.Response, error) {
+	maxAttempts := 1
+	if policy != nil && policy.MaxAttempts > 1 {
+		maxAttempts = policy.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := send()
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return nil, permanent.err
+		}
+		if attempt >= maxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		var delay time.Duration
+		event := types.RetryEvent{Attempt: attempt, Step: step}
+		switch {
+		case err != nil:
+			delay = policy.backoff(attempt)
+			event.Error = err
+		case policy.isRetryableStatus(resp.StatusCode):
+			if after, ok := retryAfter(resp); ok {
+				if policy.MaxDelay > 0 && after > policy.MaxDelay {
+					return resp, nil
+				}
+				delay = after
+			} else {
+				delay = policy.backoff(attempt)
+			}
+			event.StatusCode = resp.StatusCode
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+		event.Delay = delay
+
+		if events != nil && events.OnRetry != nil {
+			events.OnRetry(event)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
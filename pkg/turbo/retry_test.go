@@ -0,0 +1,247 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+func TestGetBalanceRetriesTransientFailures(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	responses := []*http.Response{
+		{StatusCode: 503, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("unavailable"))},
+		{StatusCode: 200, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"winc":"42"}`))},
+	}
+	calls := 0
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("connection reset by peer")
+		}
+		resp := responses[0]
+		responses = responses[1:]
+		return resp, nil
+	}
+
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, RetryPolicy: testRetryPolicy()})
+	balance, err := client.GetBalance(context.Background(), "test-address")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if balance.WinC.String() != "42" {
+		t.Errorf("Expected WinC '42', got '%s'", balance.WinC)
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetryStopsAfterMaxAttempts(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return &http.Response{StatusCode: 500, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("boom"))}, nil
+	}
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, RetryPolicy: policy})
+	_, err := client.GetUploadCosts(context.Background(), []int64{1024})
+
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+
+	if mockClient.GetRequestCount() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", mockClient.GetRequestCount())
+	}
+}
+
+func TestRetryIgnoresNonRetryableStatus(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return &http.Response{StatusCode: 400, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("bad request"))}, nil
+	}
+
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, RetryPolicy: testRetryPolicy()})
+	_, err := client.GetUploadCosts(context.Background(), []int64{1024})
+
+	if err == nil {
+		t.Fatal("Expected error for HTTP 400 response")
+	}
+
+	if mockClient.GetRequestCount() != 1 {
+		t.Errorf("Expected a single attempt, got %d", mockClient.GetRequestCount())
+	}
+}
+
+func TestRetryStopsWhenRetryAfterExceedsMaxDelay(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 429,
+			Header:     http.Header{"Retry-After": []string{"86400"}},
+			Body:       io.NopCloser(strings.NewReader("slow down")),
+		}, nil
+	}
+
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, RetryPolicy: testRetryPolicy()})
+
+	start := time.Now()
+	_, err := client.GetBalance(context.Background(), "test-address")
+
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Expected ErrRateLimited, got %v", err)
+	}
+
+	if mockClient.GetRequestCount() != 1 {
+		t.Errorf("Expected a single attempt, got %d", mockClient.GetRequestCount())
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected to return without waiting, took %s", elapsed)
+	}
+}
+
+func TestUploadRetryReopensStreamAndReportsEvents(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	attempts := 0
+	mockClient.PostFunc = func(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return &http.Response{
+				StatusCode: 429,
+				Header:     http.Header{"Retry-After": []string{"0"}},
+				Body:       io.NopCloser(strings.NewReader("slow down")),
+			}, nil
+		}
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"id":"retried-id"}`))}, nil
+	}
+
+	streamsOpened := 0
+	var retryEvents []types.RetryEvent
+	req := &types.SignedDataItemUploadRequest{
+		DataItemStreamFactory: func() (io.ReadCloser, error) {
+			streamsOpened++
+			return io.NopCloser(strings.NewReader("test-data-item")), nil
+		},
+		DataItemSizeFactory: func() int64 { return 14 },
+		Events: &types.UploadEvents{
+			OnRetry: func(event types.RetryEvent) {
+				retryEvents = append(retryEvents, event)
+			},
+		},
+	}
+
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, RetryPolicy: testRetryPolicy()})
+	result, err := client.UploadSignedDataItem(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.ID != "retried-id" {
+		t.Errorf("Expected ID 'retried-id', got '%s'", result.ID)
+	}
+
+	if streamsOpened != 2 {
+		t.Errorf("Expected stream to be opened twice, got %d", streamsOpened)
+	}
+
+	// Both attempts must have sent the full payload
+	for i, request := range mockClient.RequestHistory {
+		if request.Body != "test-data-item" {
+			t.Errorf("Expected full body on attempt %d, got '%s'", i+1, request.Body)
+		}
+	}
+
+	if len(retryEvents) != 1 {
+		t.Fatalf("Expected 1 retry event, got %d", len(retryEvents))
+	}
+
+	if retryEvents[0].StatusCode != 429 || retryEvents[0].Delay != 0 {
+		t.Errorf("Expected retry after 429 with Retry-After delay 0, got %+v", retryEvents[0])
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	delay, ok := retryAfter(resp)
+	if !ok || delay != 7*time.Second {
+		t.Errorf("Expected 7s delay, got %v (ok=%v)", delay, ok)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	delay, ok = retryAfter(resp)
+	if !ok || delay != 0 {
+		t.Errorf("Expected 0 delay for past date, got %v (ok=%v)", delay, ok)
+	}
+
+	resp.Header.Set("Retry-After", "soon")
+	if _, ok := retryAfter(resp); ok {
+		t.Error("Expected invalid Retry-After to be ignored")
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second}
+	for i, want := range expected {
+		if got := policy.backoff(i + 1); got != want {
+			t.Errorf("Expected backoff %v for retry %d, got %v", want, i+1, got)
+		}
+	}
+
+	// Without MaxDelay the backoff keeps doubling
+	uncapped := &RetryPolicy{BaseDelay: 100 * time.Millisecond}
+	if got := uncapped.backoff(6); got != 3200*time.Millisecond {
+		t.Errorf("Expected uncapped backoff 3.2s for retry 6, got %v", got)
+	}
+	if got := uncapped.backoff(100); got <= 0 {
+		t.Errorf("Expected a positive uncapped backoff for retry 100, got %v", got)
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		got := policy.backoff(1)
+		if got < 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Errorf("Expected jittered delay within [50ms, 100ms], got %v", got)
+		}
+	}
+}
+
+func TestRetryHonoursContextCancellation(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		cancel()
+		return nil, errors.New("connection refused")
+	}
+
+	client := Unauthenticated(&TurboConfig{HTTPClient: mockClient, RetryPolicy: testRetryPolicy()})
+	_, err := client.GetBalance(ctx, "test-address")
+
+	if err == nil {
+		t.Fatal("Expected error after context cancellation")
+	}
+
+	if mockClient.GetRequestCount() != 1 {
+		t.Errorf("Expected no retries after cancellation, got %d attempts", mockClient.GetRequestCount())
+	}
+}
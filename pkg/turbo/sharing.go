@@ -0,0 +1,111 @@
+package turbo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// HeaderPaidBy lists the addresses whose shared credits pay for an upload
+const HeaderPaidBy = "x-paid-by"
+
+// Data item tags that create and revoke credit share approvals
+const (
+	TagApprovePayment        = "x-approve-payment"
+	TagApprovalAmount        = "x-amount"
+	TagApprovalExpires       = "x-expires-seconds"
+	TagDeletePaymentApproval = "x-delete-payment-approval"
+)
+
+// ShareCredits approves approvedAddress to spend up to winc of the signer's credits. The approval
+// never expires when expiresBySeconds is zero.
+func (a *authenticatedClient) ShareCredits(ctx context.Context, approvedAddress string, winc types.Winc, expiresBySeconds int64) (*types.CreditShareApproval, error) {
+	if approvedAddress == "" {
+		return nil, fmt.Errorf("approved address is required")
+	}
+	if winc.Sign() <= 0 {
+		return nil, fmt.Errorf("approved winc amount must be positive")
+	}
+	if expiresBySeconds < 0 {
+		return nil, fmt.Errorf("expiresBySeconds must not be negative")
+	}
+
+	tags := []types.Tag{
+		{Name: TagApprovePayment, Value: approvedAddress},
+		{Name: TagApprovalAmount, Value: winc.String()},
+	}
+	if expiresBySeconds > 0 {
+		tags = append(tags, types.Tag{Name: TagApprovalExpires, Value: strconv.FormatInt(expiresBySeconds, 10)})
+	}
+
+	// Approvals are created by uploading a small data item carrying the approval tags
+	result, err := a.Upload(ctx, &types.UploadRequest{
+		Data: approvalNonce(approvedAddress, winc.String()),
+		Tags: tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to share credits with %s: %w", approvedAddress, err)
+	}
+	if result.CreatedApproval == nil {
+		return nil, fmt.Errorf("failed to share credits with %s: service did not create an approval", approvedAddress)
+	}
+
+	return result.CreatedApproval, nil
+}
+
+// RevokeCredits revokes every credit share approval the signer has given to revokedAddress
+func (a *authenticatedClient) RevokeCredits(ctx context.Context, revokedAddress string) ([]types.CreditShareApproval, error) {
+	if revokedAddress == "" {
+		return nil, fmt.Errorf("revoked address is required")
+	}
+
+	result, err := a.Upload(ctx, &types.UploadRequest{
+		Data: approvalNonce(revokedAddress, ""),
+		Tags: []types.Tag{{Name: TagDeletePaymentApproval, Value: revokedAddress}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke credits from %s: %w", revokedAddress, err)
+	}
+
+	return result.RevokedApprovals, nil
+}
+
+// ListShares returns the credit share approvals the signer has given and received.
+// Approvals are public, so they are looked up anonymously by the signer's address.
+func (a *authenticatedClient) ListShares(ctx context.Context) (*types.CreditShareApprovals, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	address, err := a.signer.GetNativeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet address: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/v1/account/approvals/get?userAddress=%s", a.publicClient.httpClient.GetPaymentURL(), address)
+	resp, err := a.publicClient.get(ctx, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit shares: %w", err)
+	}
+
+	// A wallet that has never shared credits has no approvals record
+	if resp.StatusCode == 404 {
+		resp.Body.Close()
+		return &types.CreditShareApprovals{}, nil
+	}
+
+	var approvals types.CreditShareApprovals
+	if err := ParseJSON(resp, &approvals); err != nil {
+		return nil, fmt.Errorf("failed to list credit shares: %w", err)
+	}
+
+	return &approvals, nil
+}
+
+// approvalNonce returns a unique payload so that repeated approval data items get distinct IDs
+func approvalNonce(address, winc string) []byte {
+	return []byte(address + winc + strconv.FormatInt(time.Now().UnixNano(), 10))
+}
@@ -0,0 +1,173 @@
+package turbo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/everFinance/goar/utils"
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// uploadedTags decodes the data item posted in a mock request and returns its tags by name
+func uploadedTags(t *testing.T, request *MockRequest) map[string]string {
+	t.Helper()
+
+	item, err := utils.DecodeBundleItem([]byte(request.Body))
+	if err != nil {
+		t.Fatalf("Failed to decode uploaded data item: %v", err)
+	}
+
+	tags := make(map[string]string, len(item.Tags))
+	for _, tag := range item.Tags {
+		tags[tag.Name] = tag.Value
+	}
+	return tags
+}
+
+func TestShareCredits(t *testing.T) {
+	signer, err := signers.NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx", jsonResponse(200, `{
+		"id": "approval-item",
+		"createdApproval": {"approvalDataItemId": "approval-item", "approvedAddress": "0xfriend", "approvedWincAmount": "1000", "usedWincAmount": "0"}
+	}`))
+
+	client := NewAuthenticatedClientForTesting(mockClient, signer)
+	approval, err := client.ShareCredits(context.Background(), "0xfriend", types.NewWinc(1000), 3600)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if approval.ApprovalDataItemID != "approval-item" || approval.ApprovedWincAmount.String() != "1000" {
+		t.Errorf("Unexpected approval: %+v", approval)
+	}
+
+	tags := uploadedTags(t, mockClient.GetLastRequest())
+	if tags[TagApprovePayment] != "0xfriend" || tags[TagApprovalAmount] != "1000" || tags[TagApprovalExpires] != "3600" {
+		t.Errorf("Unexpected approval tags: %v", tags)
+	}
+}
+
+func TestShareCreditsWithoutApproval(t *testing.T) {
+	mockSigner := signers.NewMockSigner("test-address", types.TokenTypeArweave)
+	client := NewAuthenticatedClientForTesting(NewMockHTTPClient(), mockSigner)
+
+	if _, err := client.ShareCredits(context.Background(), "friend", types.NewWinc(1000), 0); err == nil {
+		t.Error("Expected error when the service does not create an approval")
+	}
+}
+
+func TestRevokeCredits(t *testing.T) {
+	signer, err := signers.NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx", jsonResponse(200, `{
+		"id": "revoke-item",
+		"revokedApprovals": [{"approvedAddress": "0xfriend", "approvedWincAmount": "1000"}, {"approvedAddress": "0xfriend", "approvedWincAmount": "5"}]
+	}`))
+
+	client := NewAuthenticatedClientForTesting(mockClient, signer)
+	revoked, err := client.RevokeCredits(context.Background(), "0xfriend")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(revoked) != 2 {
+		t.Errorf("Expected 2 revoked approvals, got %d", len(revoked))
+	}
+
+	if tags := uploadedTags(t, mockClient.GetLastRequest()); tags[TagDeletePaymentApproval] != "0xfriend" {
+		t.Errorf("Unexpected revoke tags: %v", tags)
+	}
+}
+
+func TestListShares(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-payment.test/v1/account/approvals/get?userAddress=test-address", jsonResponse(200, `{
+		"givenApprovals": [{"approvedAddress": "friend", "payingAddress": "test-address", "approvedWincAmount": "1000", "usedWincAmount": "250"}],
+		"receivedApprovals": []
+	}`))
+
+	mockSigner := signers.NewMockSigner("test-address", types.TokenTypeArweave)
+	client := NewAuthenticatedClientForTesting(mockClient, mockSigner)
+
+	shares, err := client.ListShares(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(shares.GivenApprovals) != 1 || shares.GivenApprovals[0].UsedWincAmount.String() != "250" {
+		t.Errorf("Unexpected shares: %+v", shares)
+	}
+
+	// The approvals route does not verify signatures, so none are sent to it
+	if _, signed := mockClient.GetLastRequest().Headers[HeaderSignature]; signed {
+		t.Error("Expected the approvals lookup to be unsigned")
+	}
+}
+
+func TestUploadPaidBy(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("test-address", types.TokenTypeArweave)
+	client := NewAuthenticatedClientForTesting(mockClient, mockSigner)
+
+	_, err := client.Upload(context.Background(), &types.UploadRequest{
+		Data:   []byte("paid by a friend"),
+		PaidBy: []string{"treasury-1", "treasury-2"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if paidBy := mockClient.GetLastRequest().Headers[HeaderPaidBy]; paidBy != "treasury-1,treasury-2" {
+		t.Errorf("Expected paid-by header 'treasury-1,treasury-2', got '%s'", paidBy)
+	}
+}
+
+func TestUploadSignedDataItemWithoutPaidBy(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	client := NewUnauthenticatedClientForTesting(mockClient)
+
+	_, err := client.UploadSignedDataItem(context.Background(), &types.SignedDataItemUploadRequest{
+		DataItemStreamFactory: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("signed")), nil
+		},
+		DataItemSizeFactory: func() int64 { return 6 },
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, ok := mockClient.GetLastRequest().Headers[HeaderPaidBy]; ok {
+		t.Error("Expected no paid-by header without PaidBy")
+	}
+}
+
+func TestListSharesNotFound(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return jsonResponse(404, "Not Found"), nil
+	}
+
+	mockSigner := signers.NewMockSigner("test-address", types.TokenTypeArweave)
+	client := NewAuthenticatedClientForTesting(mockClient, mockSigner)
+
+	shares, err := client.ListShares(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(shares.GivenApprovals) != 0 || len(shares.ReceivedApprovals) != 0 {
+		t.Errorf("Expected no approvals, got %+v", shares)
+	}
+}
@@ -0,0 +1,95 @@
+package turbo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+)
+
+// Headers used by Turbo to authenticate wallet-scoped requests
+const (
+	HeaderSignature = "x-signature"
+	HeaderNonce     = "x-nonce"
+	HeaderPublicKey = "x-public-key"
+)
+
+// SignedRequestHeaders signs a fresh random nonce with the signer and returns the
+// headers Turbo expects on wallet-scoped requests
+func SignedRequestHeaders(ctx context.Context, signer signers.Signer) (map[string]string, error) {
+	if authenticator, ok := signer.(signers.RequestAuthenticator); ok {
+		signer = authenticator.RequestSigner()
+	}
+
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	nonce := hex.EncodeToString(nonceBytes)
+
+	signature, err := signer.Sign(ctx, []byte(nonce))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign nonce: %w", err)
+	}
+
+	publicKey, err := signer.GetPublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key: %w", err)
+	}
+
+	return map[string]string{
+		HeaderSignature: base64.RawURLEncoding.EncodeToString(signature),
+		HeaderNonce:     nonce,
+		HeaderPublicKey: base64.RawURLEncoding.EncodeToString(publicKey),
+	}, nil
+}
+
+// signedHTTPClient wraps an HTTPClient and authenticates every request as the signer's wallet
+type signedHTTPClient struct {
+	HTTPClient
+	signer signers.Signer
+}
+
+// newSignedHTTPClient creates an HTTPClient that adds signed-nonce headers to every request
+func newSignedHTTPClient(httpClient HTTPClient, signer signers.Signer) HTTPClient {
+	return &signedHTTPClient{
+		HTTPClient: httpClient,
+		signer:     signer,
+	}
+}
+
+func (c *signedHTTPClient) Get(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+	signedHeaders, err := c.signHeaders(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
+	return c.HTTPClient.Get(ctx, url, signedHeaders)
+}
+
+func (c *signedHTTPClient) Post(ctx context.Context, url string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	signedHeaders, err := c.signHeaders(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
+	return c.HTTPClient.Post(ctx, url, body, signedHeaders)
+}
+
+// signHeaders merges freshly signed authentication headers into the request headers
+func (c *signedHTTPClient) signHeaders(ctx context.Context, headers map[string]string) (map[string]string, error) {
+	signed, err := SignedRequestHeaders(ctx, c.signer)
+	if err != nil {
+		// Signing failures are not transient, so they must not be retried
+		return nil, &permanentError{err: err}
+	}
+
+	for key, value := range headers {
+		signed[key] = value
+	}
+
+	return signed, nil
+}
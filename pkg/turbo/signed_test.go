@@ -0,0 +1,119 @@
+package turbo
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/everFinance/goether"
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func TestGetBalanceForSignerSendsSignedHeaders(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("test-address", turboTypes.TokenTypeArweave)
+	client := NewAuthenticatedClientForTesting(mockHTTPClient, mockSigner)
+
+	ctx := context.Background()
+	if _, err := client.GetBalanceForSigner(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.GetBalanceForSigner(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Signed requests go to the route that verifies the signature
+	if url := mockHTTPClient.RequestHistory[0].URL; url != "https://mock-payment.test/v1/balance" {
+		t.Errorf("Expected signed balance request to /v1/balance, got '%s'", url)
+	}
+
+	first := mockHTTPClient.RequestHistory[0].Headers
+	second := mockHTTPClient.RequestHistory[1].Headers
+
+	if first[HeaderNonce] == "" {
+		t.Fatal("Expected x-nonce header")
+	}
+
+	if first[HeaderNonce] == second[HeaderNonce] {
+		t.Error("Expected a fresh nonce for each request")
+	}
+
+	expectedSignature := base64.RawURLEncoding.EncodeToString([]byte("mock-signature"))
+	if first[HeaderSignature] != expectedSignature {
+		t.Errorf("Expected x-signature '%s', got '%s'", expectedSignature, first[HeaderSignature])
+	}
+
+	expectedPublicKey := base64.RawURLEncoding.EncodeToString([]byte("mock-public-key"))
+	if first[HeaderPublicKey] != expectedPublicKey {
+		t.Errorf("Expected x-public-key '%s', got '%s'", expectedPublicKey, first[HeaderPublicKey])
+	}
+}
+
+func TestGetBalanceDoesNotSignAnonymousLookups(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("test-address", turboTypes.TokenTypeArweave)
+	client := NewAuthenticatedClientForTesting(mockHTTPClient, mockSigner)
+
+	if _, err := client.GetBalance(context.Background(), "other-address"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, signed := mockHTTPClient.GetLastRequest().Headers[HeaderSignature]; signed {
+		t.Error("Expected anonymous balance lookup to be unsigned")
+	}
+}
+
+func TestSignedRequestSigningError(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("test-address", turboTypes.TokenTypeArweave)
+	mockSigner.SetSignError(errors.New("hardware wallet locked"))
+	client := Authenticated(&TurboConfig{HTTPClient: mockHTTPClient, RetryPolicy: testRetryPolicy()}, mockSigner)
+
+	_, err := client.GetBalanceForSigner(context.Background())
+
+	if err == nil {
+		t.Fatal("Expected error when nonce signing fails")
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		t.Error("Expected permanentError to be unwrapped before reaching the caller")
+	}
+
+	if mockHTTPClient.GetRequestCount() != 0 {
+		t.Errorf("Expected no requests when signing fails, got %d", mockHTTPClient.GetRequestCount())
+	}
+}
+
+func TestSignedRequestHeadersTypedEthereumSigner(t *testing.T) {
+	signer, err := signers.NewTypedEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	headers, err := SignedRequestHeaders(context.Background(), signer)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Requests are authenticated with the wallet's public key, not its typed-data owner
+	publicKey, _ := base64.RawURLEncoding.DecodeString(headers[HeaderPublicKey])
+	expectedPublicKey, _ := signer.EthereumSigner.GetPublicKey()
+	if !bytes.Equal(publicKey, expectedPublicKey) {
+		t.Errorf("Expected the %d byte public key, got %d bytes", len(expectedPublicKey), len(publicKey))
+	}
+
+	// and a plain signature over the nonce that recovers to the wallet
+	signature, _ := base64.RawURLEncoding.DecodeString(headers[HeaderSignature])
+	recovered, address, err := goether.Ecrecover(accounts.TextHash([]byte(headers[HeaderNonce])), signature)
+	if err != nil {
+		t.Fatalf("Failed to recover signer: %v", err)
+	}
+	if address.Hex() != signer.Address || !bytes.Equal(recovered, publicKey) {
+		t.Errorf("Expected the nonce signature to recover to %s, got %s", signer.Address, address.Hex())
+	}
+}
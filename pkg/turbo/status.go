@@ -0,0 +1,124 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// ErrUploadFailed is returned by WaitForStatus when the service reports the data item as failed
+var ErrUploadFailed = errors.New("upload failed")
+
+// PollPolicy controls how WaitForStatus and WaitForFundTransaction poll the Turbo services
+type PollPolicy struct {
+	Interval    time.Duration // Delay before the first re-check
+	MaxInterval time.Duration // When set, the delay doubles after each check up to this bound
+	Timeout     time.Duration // Overall time limit; zero waits until ctx is done
+}
+
+// DefaultPollPolicy returns the poll policy used when WaitForStatus or WaitForFundTransaction is given nil
+func DefaultPollPolicy() *PollPolicy {
+	return &PollPolicy{
+		Interval:    5 * time.Second,
+		MaxInterval: time.Minute,
+	}
+}
+
+// next returns the delay following interval, doubling it up to MaxInterval when one is set
+func (p *PollPolicy) next(interval time.Duration) time.Duration {
+	if p.MaxInterval <= interval {
+		return interval
+	}
+	interval *= 2
+	if interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
+
+// uploadStatusRank orders the non-failed statuses so that a later status satisfies an earlier target
+var uploadStatusRank = map[types.UploadStatusCode]int{
+	types.UploadStatusPending:   0,
+	types.UploadStatusConfirmed: 1,
+	types.UploadStatusFinalized: 2,
+}
+
+// GetUploadStatus returns the current status of an uploaded data item
+func (c *unauthenticatedClient) GetUploadStatus(ctx context.Context, id string) (*types.UploadStatus, error) {
+	if id == "" {
+		return nil, fmt.Errorf("data item ID is required")
+	}
+
+	url := fmt.Sprintf("%s/v1/tx/%s/status", c.httpClient.GetUploadURL(), id)
+	resp, err := c.get(withUploadService(ctx), url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload status for %s: %w", id, err)
+	}
+
+	var status types.UploadStatus
+	if err := ParseJSON(resp, &status); err != nil {
+		return nil, fmt.Errorf("failed to get upload status for %s: %w", id, err)
+	}
+
+	if status.ID == "" {
+		status.ID = id
+	}
+	// Freshly received items are reported as NEW before they are queued for bundling
+	if status.Status == "NEW" {
+		status.Status = types.UploadStatusPending
+	}
+
+	return &status, nil
+}
+
+// WaitForStatus polls the status of a data item until it reaches target or a later status.
+// A data item the service does not know about yet is treated as pending. Reaching
+// UploadStatusFailed returns the status along with an error wrapping ErrUploadFailed.
+func (c *unauthenticatedClient) WaitForStatus(ctx context.Context, id string, target types.UploadStatusCode, pollPolicy *PollPolicy) (*types.UploadStatus, error) {
+	targetRank, ok := uploadStatusRank[target]
+	if !ok {
+		return nil, fmt.Errorf("unsupported target status %q", target)
+	}
+
+	if pollPolicy == nil {
+		pollPolicy = DefaultPollPolicy()
+	}
+	if pollPolicy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, pollPolicy.Timeout)
+		defer cancel()
+	}
+
+	interval := pollPolicy.Interval
+	if interval <= 0 {
+		interval = DefaultPollPolicy().Interval
+	}
+
+	for {
+		status, err := c.GetUploadStatus(ctx, id)
+		switch {
+		case errors.Is(err, ErrNotFound):
+		case err != nil:
+			return nil, err
+		case status.Status == types.UploadStatusFailed:
+			return status, fmt.Errorf("data item %s: %w: %s", id, ErrUploadFailed, status.FailedReason)
+		default:
+			if rank, known := uploadStatusRank[status.Status]; known && rank >= targetRank {
+				return status, nil
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("timed out waiting for data item %s to reach %s: %w", id, target, ctx.Err())
+		case <-timer.C:
+		}
+
+		interval = pollPolicy.next(interval)
+	}
+}
@@ -0,0 +1,152 @@
+package turbo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func jsonResponse(statusCode int, body string) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestGetUploadStatus(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx/item-1/status", jsonResponse(200,
+		`{"status":"CONFIRMED","bundleId":"bundle-1","winc":"1234","info":"pending"}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	status, err := client.GetUploadStatus(context.Background(), "item-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.ID != "item-1" {
+		t.Errorf("Expected ID 'item-1', got '%s'", status.ID)
+	}
+	if status.Status != types.UploadStatusConfirmed {
+		t.Errorf("Expected status CONFIRMED, got %s", status.Status)
+	}
+	if status.BundleID != "bundle-1" {
+		t.Errorf("Expected bundle ID 'bundle-1', got '%s'", status.BundleID)
+	}
+	if status.WinC.String() != "1234" {
+		t.Errorf("Expected winc '1234', got '%s'", status.WinC)
+	}
+}
+
+func TestGetUploadStatusNewIsPending(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx/item-1/status", jsonResponse(200, `{"status":"NEW"}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	status, err := client.GetUploadStatus(context.Background(), "item-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Status != types.UploadStatusPending {
+		t.Errorf("Expected status PENDING, got %s", status.Status)
+	}
+}
+
+func TestGetUploadStatusNotFound(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx/missing/status", jsonResponse(404, "Not Found"))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	_, err := client.GetUploadStatus(context.Background(), "missing")
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	responses := []string{"", `{"status":"NEW"}`, `{"status":"CONFIRMED"}`, `{"status":"FINALIZED","bundleId":"bundle-1"}`}
+	polls := 0
+
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		body := responses[polls]
+		polls++
+		if body == "" {
+			return jsonResponse(404, "Not Found"), nil
+		}
+		return jsonResponse(200, body), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	status, err := client.WaitForStatus(context.Background(), "item-1", types.UploadStatusFinalized, &PollPolicy{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Status != types.UploadStatusFinalized || status.BundleID != "bundle-1" {
+		t.Errorf("Expected finalized status with bundle ID, got %+v", status)
+	}
+	if polls != 4 {
+		t.Errorf("Expected 4 polls, got %d", polls)
+	}
+}
+
+func TestWaitForStatusLaterStatusSatisfiesTarget(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx/item-1/status", jsonResponse(200, `{"status":"FINALIZED"}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	status, err := client.WaitForStatus(context.Background(), "item-1", types.UploadStatusConfirmed, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Status != types.UploadStatusFinalized {
+		t.Errorf("Expected status FINALIZED, got %s", status.Status)
+	}
+}
+
+func TestWaitForStatusFailed(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.SetResponse("https://mock-upload.test/v1/tx/item-1/status", jsonResponse(200, `{"status":"FAILED","failedReason":"too_many_failures"}`))
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	status, err := client.WaitForStatus(context.Background(), "item-1", types.UploadStatusFinalized, nil)
+
+	if !errors.Is(err, ErrUploadFailed) {
+		t.Errorf("Expected ErrUploadFailed, got %v", err)
+	}
+	if status == nil || status.FailedReason != "too_many_failures" {
+		t.Errorf("Expected failed status with reason, got %+v", status)
+	}
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return jsonResponse(200, `{"status":"PENDING"}`), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	_, err := client.WaitForStatus(context.Background(), "item-1", types.UploadStatusFinalized, &PollPolicy{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWaitForStatusInvalidTarget(t *testing.T) {
+	client := NewUnauthenticatedClientForTesting(NewMockHTTPClient())
+
+	if _, err := client.WaitForStatus(context.Background(), "item-1", types.UploadStatusFailed, nil); err == nil {
+		t.Error("Expected error for unsupported target status")
+	}
+}
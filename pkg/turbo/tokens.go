@@ -0,0 +1,55 @@
+package turbo
+
+import (
+	"fmt"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// signerTokens maps a signer's native token type to the Turbo tokens its keys can pay with
+var signerTokens = map[types.TokenType][]types.TokenType{
+	types.TokenTypeArweave:  {types.TokenTypeArweave, types.TokenTypeArio},
+	types.TokenTypeEthereum: {types.TokenTypeEthereum, types.TokenTypeMatic, types.TokenTypePol, types.TokenTypeBaseEth, types.TokenTypeKyve},
+	types.TokenTypeSolana:   {types.TokenTypeSolana},
+	types.TokenTypeKyve:     {types.TokenTypeKyve},
+}
+
+// ValidateSigner checks that the configured token can be used with the signer's keys.
+// An empty Token is always valid and resolves to the signer's own token type.
+func (c *TurboConfig) ValidateSigner(signer signers.Signer) error {
+	if signer == nil {
+		return fmt.Errorf("signer is required")
+	}
+	if c.Token == "" {
+		return nil
+	}
+	if !c.Token.IsValid() {
+		return fmt.Errorf("unsupported token type %q", c.Token)
+	}
+
+	signerToken := signer.GetTokenType()
+	for _, token := range signerTokens[signerToken] {
+		if token == c.Token {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("token type %q cannot be used with signer of token type %s", c.Token, signerToken)
+}
+
+// tokenFor resolves the token route used by an authenticated client
+func (c *TurboConfig) tokenFor(signer signers.Signer) types.TokenType {
+	if c.Token != "" {
+		return c.Token
+	}
+	return signer.GetTokenType()
+}
+
+// unauthenticatedToken resolves the token route used by an unauthenticated client
+func (c *TurboConfig) unauthenticatedToken() types.TokenType {
+	if c.Token != "" {
+		return c.Token
+	}
+	return types.TokenTypeArweave
+}
@@ -0,0 +1,106 @@
+package turbo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/signers"
+	turboTypes "github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+func TestAuthenticatedClientUsesSignerTokenRoute(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("0xabc", turboTypes.TokenTypeEthereum)
+	client := NewAuthenticatedClientForTesting(mockHTTPClient, mockSigner)
+
+	if _, err := client.GetBalance(context.Background(), "0xabc"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedURL := "https://mock-payment.test/v1/account/balance/ethereum?address=0xabc"
+	if mockHTTPClient.GetLastRequest().URL != expectedURL {
+		t.Errorf("Expected URL '%s', got '%s'", expectedURL, mockHTTPClient.GetLastRequest().URL)
+	}
+}
+
+func TestAuthenticatedClientUsesConfiguredToken(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("0xabc", turboTypes.TokenTypeEthereum)
+	config := &TurboConfig{HTTPClient: mockHTTPClient, Token: turboTypes.TokenTypeBaseEth}
+
+	client, err := NewAuthenticatedClientFromConfig(config, mockSigner)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := client.GetBalance(context.Background(), "0xabc"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedURL := "https://mock-payment.test/v1/account/balance/base-eth?address=0xabc"
+	if mockHTTPClient.GetLastRequest().URL != expectedURL {
+		t.Errorf("Expected URL '%s', got '%s'", expectedURL, mockHTTPClient.GetLastRequest().URL)
+	}
+}
+
+func TestValidateSigner(t *testing.T) {
+	testCases := []struct {
+		signerToken turboTypes.TokenType
+		token       turboTypes.TokenType
+		valid       bool
+	}{
+		{turboTypes.TokenTypeArweave, "", true},
+		{turboTypes.TokenTypeArweave, turboTypes.TokenTypeArweave, true},
+		{turboTypes.TokenTypeArweave, turboTypes.TokenTypeArio, true},
+		{turboTypes.TokenTypeArweave, turboTypes.TokenTypeEthereum, false},
+		{turboTypes.TokenTypeEthereum, turboTypes.TokenTypeMatic, true},
+		{turboTypes.TokenTypeEthereum, turboTypes.TokenTypePol, true},
+		{turboTypes.TokenTypeEthereum, turboTypes.TokenTypeBaseEth, true},
+		{turboTypes.TokenTypeEthereum, turboTypes.TokenTypeSolana, false},
+		{turboTypes.TokenTypeSolana, turboTypes.TokenTypeSolana, true},
+		{turboTypes.TokenTypeEthereum, turboTypes.TokenTypeKyve, true},
+		{turboTypes.TokenTypeEthereum, "dogecoin", false},
+	}
+
+	for _, tc := range testCases {
+		config := &TurboConfig{Token: tc.token}
+		err := config.ValidateSigner(signers.NewMockSigner("address", tc.signerToken))
+		if (err == nil) != tc.valid {
+			t.Errorf("ValidateSigner(%s signer, token %q) = %v, expected valid=%v", tc.signerToken, tc.token, err, tc.valid)
+		}
+	}
+}
+
+func TestValidateSignerKyveWithEthereumSigner(t *testing.T) {
+	// Kyve keys are secp256k1 keys signed as Ethereum data items
+	signer, err := signers.NewEthereumSigner(testEthereumPrivateKey)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	config := &TurboConfig{HTTPClient: NewMockHTTPClient(), Token: turboTypes.TokenTypeKyve}
+	if _, err := NewAuthenticatedClientFromConfig(config, signer); err != nil {
+		t.Errorf("Expected kyve to be usable with an Ethereum signer, got %v", err)
+	}
+}
+
+func TestFactoryAuthenticatedRejectsMismatchedToken(t *testing.T) {
+	mockHTTPClient := NewMockHTTPClient()
+	mockSigner := signers.NewMockSigner("test-address", turboTypes.TokenTypeArweave)
+	config := &TurboConfig{HTTPClient: mockHTTPClient, Token: turboTypes.TokenTypeEthereum}
+
+	if _, err := NewAuthenticatedClientFromConfig(config, mockSigner); err == nil {
+		t.Error("Expected error for mismatched signer and token")
+	}
+
+	client := Authenticated(config, mockSigner)
+	_, err := client.GetBalanceForSigner(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "cannot be used with signer of token type arweave") {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+
+	if mockHTTPClient.GetRequestCount() != 0 {
+		t.Errorf("Expected no requests for misconfigured client, got %d", mockHTTPClient.GetRequestCount())
+	}
+}
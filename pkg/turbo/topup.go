@@ -0,0 +1,132 @@
+package turbo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+// CreateCheckoutSession creates a fiat top-up session crediting owner. amount is given in
+// the smallest unit of currency (e.g. cents for "usd").
+func (c *unauthenticatedClient) CreateCheckoutSession(ctx context.Context, owner string, amount int64, currency string, opts *types.CheckoutSessionOptions) (*types.CheckoutSession, error) {
+	if owner == "" {
+		return nil, fmt.Errorf("owner address is required")
+	}
+	return c.createCheckoutSession(ctx, owner, amount, currency, opts, url.Values{})
+}
+
+// CreateGiftCheckoutSession creates a hosted checkout for credits gifted to recipientEmail. Once
+// paid, the recipient is emailed a code that can be redeemed into any wallet with RedeemGift.
+func (c *unauthenticatedClient) CreateGiftCheckoutSession(ctx context.Context, recipientEmail string, amount int64, currency, message string) (*types.CheckoutSession, error) {
+	if !strings.Contains(recipientEmail, "@") {
+		return nil, fmt.Errorf("a valid recipient email is required")
+	}
+
+	query := url.Values{}
+	query.Set("destinationAddressType", "email")
+	if message != "" {
+		query.Set("giftMessage", message)
+	}
+
+	return c.createCheckoutSession(ctx, recipientEmail, amount, currency, nil, query)
+}
+
+// RedeemGift credits the gift identified by code, sent to email, to destinationAddress
+func (c *unauthenticatedClient) RedeemGift(ctx context.Context, code, email, destinationAddress string) (*types.GiftRedemption, error) {
+	if code == "" {
+		return nil, fmt.Errorf("redemption code is required")
+	}
+	if email == "" {
+		return nil, fmt.Errorf("email is required")
+	}
+	if destinationAddress == "" {
+		return nil, fmt.Errorf("destination address is required")
+	}
+
+	query := url.Values{}
+	query.Set("id", code)
+	query.Set("email", email)
+	query.Set("destinationAddress", destinationAddress)
+	query.Set("token", c.token)
+
+	resp, err := c.get(ctx, fmt.Sprintf("%s/v1/redeem?%s", c.httpClient.GetPaymentURL(), query.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeem gift: %w", err)
+	}
+
+	var redemption types.GiftRedemption
+	if err := ParseJSON(resp, &redemption); err != nil {
+		return nil, fmt.Errorf("failed to redeem gift: %w", err)
+	}
+
+	return &redemption, nil
+}
+
+// createCheckoutSession requests a checkout session for destination, adding query to the
+// parameters derived from opts
+func (c *unauthenticatedClient) createCheckoutSession(ctx context.Context, destination string, amount int64, currency string, opts *types.CheckoutSessionOptions, query url.Values) (*types.CheckoutSession, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if currency == "" {
+		return nil, fmt.Errorf("currency is required")
+	}
+	if opts == nil {
+		opts = &types.CheckoutSessionOptions{}
+	}
+
+	uiMode := opts.UIMode
+	if uiMode == "" {
+		uiMode = types.CheckoutUIModeHosted
+	}
+
+	var endpoint string
+	switch uiMode {
+	case types.CheckoutUIModeHosted:
+		endpoint = "checkout-session"
+	case types.CheckoutUIModeEmbedded:
+		endpoint = "payment-intent"
+	default:
+		return nil, fmt.Errorf("unsupported checkout UI mode %q", uiMode)
+	}
+
+	token := string(opts.DestinationToken)
+	if token == "" {
+		token = c.token
+	}
+
+	query.Set("uiMode", string(uiMode))
+	query.Set("token", token)
+	for _, code := range opts.PromoCodes {
+		query.Add("promoCode", code)
+	}
+	if opts.SuccessURL != "" {
+		query.Set("successUrl", opts.SuccessURL)
+	}
+	if opts.CancelURL != "" {
+		query.Set("cancelUrl", opts.CancelURL)
+	}
+
+	checkoutURL := fmt.Sprintf("%s/v1/top-up/%s/%s/%s/%d?%s",
+		c.httpClient.GetPaymentURL(), endpoint, url.PathEscape(destination), strings.ToLower(currency), amount, query.Encode())
+	resp, err := c.get(ctx, checkoutURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+
+	var session types.CheckoutSession
+	if err := ParseJSON(resp, &session); err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+
+	session.URL = session.PaymentSession.URL
+	session.ID = session.PaymentSession.ID
+	session.ClientSecret = session.PaymentSession.ClientSecret
+	session.PaymentIntent = session.PaymentSession.PaymentIntent
+	session.WinC = session.TopUpQuote.WinstonCreditAmount
+
+	return &session, nil
+}
@@ -0,0 +1,171 @@
+package turbo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
+)
+
+const checkoutSessionResponse = `{
+	"topUpQuote": {
+		"topUpQuoteId": "quote-1",
+		"destinationAddress": "owner-address",
+		"destinationAddressType": "arweave",
+		"paymentAmount": 1000,
+		"quotedPaymentAmount": 1250,
+		"currencyType": "usd",
+		"winstonCreditAmount": "1500000000000",
+		"quoteExpirationDate": "2024-01-01T00:00:00.000Z",
+		"paymentProvider": "stripe",
+		"adjustments": [{"name": "Promo", "description": "20% off", "operator": "multiply", "operatorMagnitude": 0.8, "adjustmentAmount": -250}]
+	},
+	"paymentSession": {"id": "cs_test_1", "url": "https://checkout.test/cs_test_1", "payment_intent": "pi_1"},
+	"adjustments": [{"name": "Promo", "description": "20% off", "operator": "multiply", "operatorMagnitude": 0.8, "adjustmentAmount": -250}]
+}`
+
+func TestCreateCheckoutSession(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return jsonResponse(200, checkoutSessionResponse), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	session, err := client.CreateCheckoutSession(context.Background(), "owner-address", 1250, "USD", &types.CheckoutSessionOptions{
+		PromoCodes: []string{"SPRING", "VIP"},
+		SuccessURL: "https://app.test/success",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if session.URL != "https://checkout.test/cs_test_1" || session.ID != "cs_test_1" || session.PaymentIntent != "pi_1" {
+		t.Errorf("Unexpected session fields: %+v", session)
+	}
+	if session.WinC.String() != "1500000000000" {
+		t.Errorf("Expected winc '1500000000000', got '%s'", session.WinC)
+	}
+	if len(session.Adjustments) != 1 || session.Adjustments[0].AdjustmentAmount.String() != "-250" {
+		t.Errorf("Expected one -250 adjustment, got %+v", session.Adjustments)
+	}
+
+	requested, err := url.Parse(mockClient.GetLastRequest().URL)
+	if err != nil {
+		t.Fatalf("Failed to parse request URL: %v", err)
+	}
+	if requested.Path != "/v1/top-up/checkout-session/owner-address/usd/1250" {
+		t.Errorf("Unexpected request path: %s", requested.Path)
+	}
+
+	query := requested.Query()
+	if query.Get("uiMode") != "hosted" || query.Get("token") != "arweave" || query.Get("successUrl") != "https://app.test/success" {
+		t.Errorf("Unexpected query: %s", requested.RawQuery)
+	}
+	if codes := query["promoCode"]; len(codes) != 2 || codes[0] != "SPRING" || codes[1] != "VIP" {
+		t.Errorf("Expected both promo codes, got %v", codes)
+	}
+}
+
+func TestCreateCheckoutSessionEmbedded(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return jsonResponse(200, `{"topUpQuote":{"winstonCreditAmount":"42"},"paymentSession":{"id":"pi_2","client_secret":"secret"}}`), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	session, err := client.CreateCheckoutSession(context.Background(), "0xabc", 500, "eur", &types.CheckoutSessionOptions{
+		UIMode:           types.CheckoutUIModeEmbedded,
+		DestinationToken: types.TokenTypeEthereum,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if session.ClientSecret != "secret" || session.URL != "" || session.WinC.String() != "42" {
+		t.Errorf("Unexpected embedded session: %+v", session)
+	}
+
+	requested, _ := url.Parse(mockClient.GetLastRequest().URL)
+	if requested.Path != "/v1/top-up/payment-intent/0xabc/eur/500" {
+		t.Errorf("Unexpected request path: %s", requested.Path)
+	}
+	if requested.Query().Get("token") != "ethereum" {
+		t.Errorf("Expected destination token 'ethereum', got %s", requested.Query().Get("token"))
+	}
+}
+
+func TestCreateCheckoutSessionValidation(t *testing.T) {
+	client := NewUnauthenticatedClientForTesting(NewMockHTTPClient())
+	ctx := context.Background()
+
+	if _, err := client.CreateCheckoutSession(ctx, "", 100, "usd", nil); err == nil {
+		t.Error("Expected error for missing owner")
+	}
+	if _, err := client.CreateCheckoutSession(ctx, "owner", 0, "usd", nil); err == nil {
+		t.Error("Expected error for non-positive amount")
+	}
+	if _, err := client.CreateCheckoutSession(ctx, "owner", 100, "usd", &types.CheckoutSessionOptions{UIMode: "popup"}); err == nil {
+		t.Error("Expected error for unsupported UI mode")
+	}
+}
+
+func TestCreateGiftCheckoutSession(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return jsonResponse(200, checkoutSessionResponse), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	session, err := client.CreateGiftCheckoutSession(context.Background(), "friend@example.com", 1000, "usd", "Happy uploading!")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if session.URL != "https://checkout.test/cs_test_1" {
+		t.Errorf("Expected hosted checkout URL, got '%s'", session.URL)
+	}
+
+	requested, _ := url.Parse(mockClient.GetLastRequest().URL)
+	if requested.Path != "/v1/top-up/checkout-session/friend@example.com/usd/1000" {
+		t.Errorf("Unexpected request path: %s", requested.Path)
+	}
+
+	query := requested.Query()
+	if query.Get("destinationAddressType") != "email" || query.Get("giftMessage") != "Happy uploading!" || query.Get("uiMode") != "hosted" {
+		t.Errorf("Unexpected query: %s", requested.RawQuery)
+	}
+
+	if _, err := client.CreateGiftCheckoutSession(context.Background(), "not-an-email", 1000, "usd", ""); err == nil {
+		t.Error("Expected error for invalid recipient email")
+	}
+}
+
+func TestRedeemGift(t *testing.T) {
+	mockClient := NewMockHTTPClient()
+	mockClient.GetFunc = func(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+		return jsonResponse(200, `{"message":"Payment receipt redeemed for 1000 winc!","userAddress":"wallet-1","userBalance":{"winc":"1000"}}`), nil
+	}
+
+	client := NewUnauthenticatedClientForTesting(mockClient)
+	redemption, err := client.RedeemGift(context.Background(), "GIFT-CODE", "friend@example.com", "wallet-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if redemption.UserAddress != "wallet-1" || redemption.UserBalance.WinC.String() != "1000" {
+		t.Errorf("Unexpected redemption: %+v", redemption)
+	}
+
+	requested, _ := url.Parse(mockClient.GetLastRequest().URL)
+	query := requested.Query()
+	if requested.Path != "/v1/redeem" || query.Get("id") != "GIFT-CODE" || query.Get("email") != "friend@example.com" ||
+		query.Get("destinationAddress") != "wallet-1" || query.Get("token") != "arweave" {
+		t.Errorf("Unexpected redeem request: %s", requested)
+	}
+
+	if _, err := client.RedeemGift(context.Background(), "", "friend@example.com", "wallet-1"); err == nil {
+		t.Error("Expected error for missing code")
+	}
+}
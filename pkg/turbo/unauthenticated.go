@@ -1,20 +1,24 @@
 package turbo
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
-	"time"
+	"strings"
 
 	"github.com/project-kardeshev/go-ardrive-turbo/pkg/types"
 )
 
-// unauthenticatedClient implements TurboUnauthenticatedClient as a standalone client
+// unauthenticatedClient implements TurboUnauthenticatedClient on top of an HTTPClient transport
 type unauthenticatedClient struct {
-	client     *http.Client
-	paymentURL string
-	uploadURL  string
-	token      string
+	httpClient       HTTPClient
+	token            string
+	retryPolicy      *RetryPolicy
+	priceConcurrency int         // parallel price requests; zero means DefaultPriceConcurrency
+	priceCache       *PriceCache // optional cache of upload quotes
 }
 
 // NewUnauthenticatedClient creates a new unauthenticated Turbo client
@@ -24,207 +28,100 @@ func NewUnauthenticatedClient(paymentURL, uploadURL string) TurboUnauthenticated
 
 // NewUnauthenticatedClientWithToken creates a new unauthenticated Turbo client with token type
 func NewUnauthenticatedClientWithToken(paymentURL, uploadURL, token string) TurboUnauthenticatedClient {
-	return &unauthenticatedClient{
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		paymentURL: paymentURL,
-		uploadURL:  uploadURL,
-		token:      token,
-	}
+	return NewUnauthenticatedClientWithHTTPClient(NewDefaultHTTPClient(paymentURL, uploadURL), token)
+}
+
+// NewUnauthenticatedClientWithHTTPClient creates a new unauthenticated Turbo client that sends
+// all requests through the provided HTTPClient
+func NewUnauthenticatedClientWithHTTPClient(httpClient HTTPClient, token string) TurboUnauthenticatedClient {
+	return newUnauthenticatedClient(httpClient, token)
 }
 
 // NewUnauthenticatedClientForTesting creates a new unauthenticated Turbo client with HTTPClient injection for testing
 func NewUnauthenticatedClientForTesting(httpClient HTTPClient) TurboUnauthenticatedClient {
-	return &testableUnauthenticatedClient{
+	return newUnauthenticatedClient(httpClient, "arweave")
+}
+
+func newUnauthenticatedClient(httpClient HTTPClient, token string) *unauthenticatedClient {
+	return &unauthenticatedClient{
 		httpClient: httpClient,
+		token:      token,
 	}
 }
 
-// testableUnauthenticatedClient is a test-friendly implementation that wraps HTTPClient
-type testableUnauthenticatedClient struct {
-	httpClient HTTPClient
+// newUnauthenticatedClientFromConfig creates an unauthenticated client honouring every TurboConfig option
+func newUnauthenticatedClientFromConfig(config *TurboConfig, token string) *unauthenticatedClient {
+	client := newUnauthenticatedClient(config.httpClient(), token)
+	client.retryPolicy = config.RetryPolicy
+	client.priceConcurrency = config.PriceConcurrency
+	client.priceCache = config.PriceCache
+	return client
 }
 
-// GetBalance implementation for testable client
-func (c *testableUnauthenticatedClient) GetBalance(ctx context.Context, address string) (*types.Balance, error) {
-	url := fmt.Sprintf("%s/v1/account/balance/arweave?address=%s", c.httpClient.GetPaymentURL(), address)
-	resp, err := c.httpClient.Get(ctx, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get balance: %w", err)
-	}
-
-	var balance types.Balance
-	if err := ParseJSON(resp, &balance); err != nil {
-		return nil, err
-	}
-
-	return &balance, nil
+// get performs a GET request, retrying transient failures according to the client's retry policy
+func (c *unauthenticatedClient) get(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+	return doWithRetry(ctx, c.retryPolicy, "", nil, func() (*http.Response, error) {
+		return c.httpClient.Get(ctx, url, headers)
+	})
 }
 
-// GetUploadCosts implementation for testable client
-func (c *testableUnauthenticatedClient) GetUploadCosts(ctx context.Context, bytes []int64) ([]types.UploadCost, error) {
-	// Make individual requests for each byte count (matching TypeScript implementation)
-	costs := make([]types.UploadCost, len(bytes))
-	
-	for i, byteCount := range bytes {
-		url := fmt.Sprintf("%s/v1/price/bytes/%d", c.httpClient.GetPaymentURL(), byteCount)
-		resp, err := c.httpClient.Get(ctx, url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get upload cost for byte count %d: %w", byteCount, err)
-		}
-
-		var cost types.UploadCost
-		if err := ParseJSON(resp, &cost); err != nil {
-			return nil, fmt.Errorf("failed to parse response for byte count %d: %w", byteCount, err)
-		}
-		
-		// Set the byte count since the API doesn't return it
-		cost.Bytes = byteCount
-		
-		costs[i] = cost
-	}
-
-	return costs, nil
+// post performs a POST request with an in-memory body, retrying transient failures
+// according to the client's retry policy
+func (c *unauthenticatedClient) post(ctx context.Context, url string, body []byte, headers map[string]string) (*http.Response, error) {
+	return doWithRetry(ctx, c.retryPolicy, "", nil, func() (*http.Response, error) {
+		return c.httpClient.Post(ctx, url, bytes.NewReader(body), headers)
+	})
 }
 
-// UploadSignedDataItem implementation for testable client
-func (c *testableUnauthenticatedClient) UploadSignedDataItem(ctx context.Context, req *types.SignedDataItemUploadRequest) (*types.UploadResult, error) {
-	if req == nil {
-		return nil, fmt.Errorf("upload request is required")
-	}
-
-	// Get data stream
-	dataStream, err := req.DataItemStreamFactory()
+// postJSON POSTs v encoded as JSON
+func (c *unauthenticatedClient) postJSON(ctx context.Context, url string, v interface{}) (*http.Response, error) {
+	body, err := json.Marshal(v)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create data stream: %w", err)
-	}
-	defer dataStream.Close()
-
-	// Notify upload start
-	if req.Events != nil && req.Events.OnUploadStart != nil {
-		req.Events.OnUploadStart()
+		return nil, fmt.Errorf("failed to encode request body: %w", err)
 	}
-	if req.Events != nil && req.Events.OnProgress != nil {
-		req.Events.OnProgress(types.ProgressEvent{
-			TotalBytes:     req.DataItemSizeFactory(),
-			ProcessedBytes: 0,
-			Step:           "uploading",
-		})
-	}
-
-	// Upload the data item
-	url := fmt.Sprintf("%s/v1/tx", c.httpClient.GetUploadURL())
-	resp, err := c.httpClient.Post(ctx, url, dataStream, map[string]string{
-		"Content-Type": "application/octet-stream",
-	})
-	if err != nil {
-		if req.Events != nil && req.Events.OnUploadError != nil {
-			req.Events.OnUploadError(err)
-		}
-		if req.Events != nil && req.Events.OnError != nil {
-			req.Events.OnError(types.ErrorEvent{Error: err, Step: "uploading"})
-		}
-		return nil, fmt.Errorf("failed to upload data item: %w", err)
-	}
-
-	// Parse the response
-	var result types.UploadResult
-	if err := ParseJSON(resp, &result); err != nil {
-		if req.Events != nil && req.Events.OnUploadError != nil {
-			req.Events.OnUploadError(err)
-		}
-		if req.Events != nil && req.Events.OnError != nil {
-			req.Events.OnError(types.ErrorEvent{Error: err, Step: "uploading"})
-		}
-		return nil, err
-	}
-
-	// Notify upload success
-	if req.Events != nil && req.Events.OnUploadSuccess != nil {
-		req.Events.OnUploadSuccess(&result)
-	}
-	if req.Events != nil && req.Events.OnProgress != nil {
-		req.Events.OnProgress(types.ProgressEvent{
-			TotalBytes:     req.DataItemSizeFactory(),
-			ProcessedBytes: req.DataItemSizeFactory(),
-			Step:           "uploading",
-		})
-	}
-
-	return &result, nil
+	return c.post(ctx, url, body, map[string]string{"Content-Type": "application/json"})
 }
 
 // GetBalance returns the credit balance for a given address (unauthenticated version)
 func (c *unauthenticatedClient) GetBalance(ctx context.Context, address string) (*types.Balance, error) {
-	url := fmt.Sprintf("%s/v1/account/balance/%s?address=%s", c.paymentURL, c.token, address)
-	
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	resp, err := c.client.Do(req)
+	return c.getBalance(ctx, fmt.Sprintf("%s/v1/account/balance/%s?address=%s", c.httpClient.GetPaymentURL(), c.token, address))
+}
+
+// getBalance fetches and decodes a balance from the payment service
+func (c *unauthenticatedClient) getBalance(ctx context.Context, url string) (*types.Balance, error) {
+	resp, err := c.get(ctx, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get balance: %w", err)
 	}
 
-	// Handle 404 responses by returning default balance (matching TypeScript implementation)
+	// The payment service reports wallets it has never seen as a 404
 	if resp.StatusCode == 404 {
-		return &types.Balance{
-			WinC:     "0",
-			Credits:  "0",
-			Currency: "USD",
-		}, nil
+		resp.Body.Close()
+		return noAccountBalance(), nil
 	}
 
-	var balance types.Balance
-	if err := ParseJSON(resp, &balance); err != nil {
+	var raw json.RawMessage
+	if err := ParseJSON(resp, &raw); err != nil {
 		return nil, err
 	}
 
-	// If balance is empty, return default balance (matching TypeScript implementation)
-	if balance.WinC == "" {
-		return &types.Balance{
-			WinC:     "0",
-			Credits:  "0",
-			Currency: "USD",
-		}, nil
+	// A response without a winc amount also means there is no account
+	var presence struct {
+		WinC json.RawMessage `json:"winc"`
+	}
+	if err := json.Unmarshal(raw, &presence); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
+	}
+	if len(presence.WinC) == 0 || string(presence.WinC) == "null" || string(presence.WinC) == `""` {
+		return noAccountBalance(), nil
 	}
 
-	return &balance, nil
-}
-
-// GetUploadCosts returns the estimated cost in Winston Credits for the provided file sizes
-func (c *unauthenticatedClient) GetUploadCosts(ctx context.Context, bytes []int64) ([]types.UploadCost, error) {
-	// Make individual requests for each byte count (matching TypeScript implementation)
-	costs := make([]types.UploadCost, len(bytes))
-	
-	for i, byteCount := range bytes {
-		url := fmt.Sprintf("%s/v1/price/bytes/%d", c.paymentURL, byteCount)
-		
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request for byte count %d: %w", byteCount, err)
-		}
-		
-		resp, err := c.client.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get upload cost for byte count %d: %w", byteCount, err)
-		}
-
-		var cost types.UploadCost
-		if err := ParseJSON(resp, &cost); err != nil {
-			return nil, fmt.Errorf("failed to parse response for byte count %d: %w", byteCount, err)
-		}
-		
-		// Set the byte count since the API doesn't return it
-		cost.Bytes = byteCount
-		
-		costs[i] = cost
+	var balance types.Balance
+	if err := json.Unmarshal(raw, &balance); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
 	}
 
-	return costs, nil
+	return &balance, nil
 }
 
 // UploadSignedDataItem uploads a pre-signed data item
@@ -232,13 +129,14 @@ func (c *unauthenticatedClient) UploadSignedDataItem(ctx context.Context, req *t
 	if req == nil {
 		return nil, fmt.Errorf("upload request is required")
 	}
+	ctx = withUploadService(ctx)
 
 	// Get data stream
 	dataStream, err := req.DataItemStreamFactory()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create data stream: %w", err)
 	}
-	defer dataStream.Close()
+	size := req.DataItemSizeFactory()
 
 	// Notify upload start
 	if req.Events != nil && req.Events.OnUploadStart != nil {
@@ -246,48 +144,47 @@ func (c *unauthenticatedClient) UploadSignedDataItem(ctx context.Context, req *t
 	}
 	if req.Events != nil && req.Events.OnProgress != nil {
 		req.Events.OnProgress(types.ProgressEvent{
-			TotalBytes:     req.DataItemSizeFactory(),
+			TotalBytes:     size,
 			ProcessedBytes: 0,
-			Step:           "uploading",
+			Step:           types.StepUploading,
 		})
 	}
 
-	// Upload the data item
-	url := fmt.Sprintf("%s/v1/tx", c.uploadURL)
-	
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, dataStream)
-	if err != nil {
-		if req.Events != nil && req.Events.OnUploadError != nil {
-			req.Events.OnUploadError(err)
-		}
-		if req.Events != nil && req.Events.OnError != nil {
-			req.Events.OnError(types.ErrorEvent{Error: err, Step: "uploading"})
+	// Upload the data item, reopening the stream for every retry
+	url := fmt.Sprintf("%s/v1/tx", c.httpClient.GetUploadURL())
+	attempt := 0
+	resp, err := doWithRetry(ctx, c.retryPolicy, types.StepUploading, req.Events, func() (*http.Response, error) {
+		attempt++
+		if attempt > 1 {
+			dataStream, err = req.DataItemStreamFactory()
+			if err != nil {
+				return nil, &permanentError{err: fmt.Errorf("failed to create data stream: %w", err)}
+			}
 		}
-		return nil, fmt.Errorf("failed to create upload request: %w", err)
-	}
-	
-	httpReq.Header.Set("Content-Type", "application/octet-stream")
-	
-	resp, err := c.client.Do(httpReq)
+		defer dataStream.Close()
+
+		// Progress is reported as the transport consumes the body
+		body := newProgressReader(dataStream, size, types.StepUploading, req.Events)
+		return c.httpClient.Post(ctx, url, body, uploadHeaders(req.PaidBy))
+	})
 	if err != nil {
-		if req.Events != nil && req.Events.OnUploadError != nil {
-			req.Events.OnUploadError(err)
-		}
-		if req.Events != nil && req.Events.OnError != nil {
-			req.Events.OnError(types.ErrorEvent{Error: err, Step: "uploading"})
-		}
+		notifyUploadError(req.Events, err)
 		return nil, fmt.Errorf("failed to upload data item: %w", err)
 	}
 
+	// Turbo answers a duplicate data item with 202 "Data Item Exists" instead of a receipt
+	if resp.StatusCode == http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		err := fmt.Errorf("%w: %s", ErrAlreadyUploaded, strings.TrimSpace(string(body)))
+		notifyUploadError(req.Events, err)
+		return nil, err
+	}
+
 	// Parse the response
 	var result types.UploadResult
 	if err := ParseJSON(resp, &result); err != nil {
-		if req.Events != nil && req.Events.OnUploadError != nil {
-			req.Events.OnUploadError(err)
-		}
-		if req.Events != nil && req.Events.OnError != nil {
-			req.Events.OnError(types.ErrorEvent{Error: err, Step: "uploading"})
-		}
+		notifyUploadError(req.Events, err)
 		return nil, err
 	}
 
@@ -295,13 +192,35 @@ func (c *unauthenticatedClient) UploadSignedDataItem(ctx context.Context, req *t
 	if req.Events != nil && req.Events.OnUploadSuccess != nil {
 		req.Events.OnUploadSuccess(&result)
 	}
-	if req.Events != nil && req.Events.OnProgress != nil {
-		req.Events.OnProgress(types.ProgressEvent{
-			TotalBytes:     req.DataItemSizeFactory(),
-			ProcessedBytes: req.DataItemSizeFactory(),
-			Step:           "uploading",
-		})
-	}
 
 	return &result, nil
 }
+
+// uploadHeaders returns the headers sent with a data item upload
+func uploadHeaders(paidBy []string) map[string]string {
+	headers := map[string]string{
+		"Content-Type": "application/octet-stream",
+	}
+	if len(paidBy) > 0 {
+		headers[HeaderPaidBy] = strings.Join(paidBy, ",")
+	}
+	return headers
+}
+
+// noAccountBalance returns the zero balance reported for wallets without an account
+func noAccountBalance() *types.Balance {
+	return &types.Balance{NoAccount: true}
+}
+
+// notifyUploadError dispatches an upload failure to the relevant event callbacks
+func notifyUploadError(events *types.UploadEvents, err error) {
+	if events == nil {
+		return
+	}
+	if events.OnUploadError != nil {
+		events.OnUploadError(err)
+	}
+	if events.OnError != nil {
+		events.OnError(types.ErrorEvent{Error: err, Step: types.StepUploading})
+	}
+}
@@ -0,0 +1,140 @@
+package types
+
+import "encoding/json"
+
+// CheckoutUIMode selects how a top-up checkout is presented to the buyer
+type CheckoutUIMode string
+
+const (
+	CheckoutUIModeHosted   CheckoutUIMode = "hosted"   // Redirect to a checkout page hosted by the payment provider
+	CheckoutUIModeEmbedded CheckoutUIMode = "embedded" // Collect payment in-app using the returned client secret
+)
+
+// CheckoutSessionOptions configures a fiat top-up checkout session
+type CheckoutSessionOptions struct {
+	PromoCodes       []string       // Promotional codes applied to the quote
+	UIMode           CheckoutUIMode // Defaults to CheckoutUIModeHosted
+	DestinationToken TokenType      // Token type of the owner address; defaults to the client's token
+	SuccessURL       string         // Hosted mode only: where the buyer lands after paying
+	CancelURL        string         // Hosted mode only: where the buyer lands after cancelling
+}
+
+// PaymentAdjustment describes a discount or fee applied to a fiat payment quote.
+// AdjustmentAmount is expressed in the smallest unit of the payment currency.
+type PaymentAdjustment struct {
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	Operator          AdjustmentOperator `json:"operator"`
+	OperatorMagnitude float64            `json:"operatorMagnitude"`
+	AdjustmentAmount  json.Number        `json:"adjustmentAmount"`
+}
+
+// TopUpQuote is the payment service's quote for a fiat top-up
+type TopUpQuote struct {
+	TopUpQuoteID           string              `json:"topUpQuoteId"`
+	DestinationAddress     string              `json:"destinationAddress"`
+	DestinationAddressType string              `json:"destinationAddressType"`
+	PaymentAmount          int64               `json:"paymentAmount"`
+	QuotedPaymentAmount    int64               `json:"quotedPaymentAmount"`
+	CurrencyType           string              `json:"currencyType"`
+	WinstonCreditAmount    Winc                `json:"winstonCreditAmount"`
+	QuoteExpirationDate    string              `json:"quoteExpirationDate"`
+	PaymentProvider        string              `json:"paymentProvider"`
+	Adjustments            []PaymentAdjustment `json:"adjustments,omitempty"`
+}
+
+// PaymentSession is the payment provider session backing a checkout
+type PaymentSession struct {
+	ID            string `json:"id"`
+	URL           string `json:"url,omitempty"`
+	ClientSecret  string `json:"client_secret,omitempty"`
+	PaymentIntent string `json:"payment_intent,omitempty"`
+}
+
+// CheckoutSession is the result of creating a fiat top-up checkout session
+type CheckoutSession struct {
+	URL           string `json:"-"` // Hosted checkout URL; empty in embedded mode
+	ID            string `json:"-"` // Payment provider session ID
+	ClientSecret  string `json:"-"` // Embedded mode: secret used to confirm the payment client-side
+	PaymentIntent string `json:"-"` // Payment intent backing the session, when known
+	WinC          Winc   `json:"-"` // Winston credits the buyer receives once paid
+
+	TopUpQuote     TopUpQuote          `json:"topUpQuote"`
+	PaymentSession PaymentSession      `json:"paymentSession"`
+	Adjustments    []PaymentAdjustment `json:"adjustments,omitempty"`
+	Fees           []PaymentAdjustment `json:"fees,omitempty"`
+}
+
+// GiftRedemption is the result of redeeming gifted credits into a wallet
+type GiftRedemption struct {
+	Message     string  `json:"message"`
+	UserAddress string  `json:"userAddress"`
+	UserBalance Balance `json:"userBalance"` // Balance of the destination wallet after redemption
+}
+
+// FundTransactionStatus is the crediting state of a crypto funding transaction
+type FundTransactionStatus string
+
+const (
+	FundTransactionPending   FundTransactionStatus = "pending"   // Seen but not yet confirmed on chain
+	FundTransactionConfirmed FundTransactionStatus = "confirmed" // Confirmed and credited to the destination address
+	FundTransactionFailed    FundTransactionStatus = "failed"    // Rejected by the payment service
+)
+
+// FundTransaction describes a native token transfer to a Turbo funding address
+type FundTransaction struct {
+	ID                     string                `json:"transactionId"`
+	Status                 FundTransactionStatus `json:"status"`
+	TokenType              TokenType             `json:"tokenType"`
+	Quantity               string                `json:"transactionQuantity"` // Amount transferred in the token's base unit
+	WinC                   Winc                  `json:"winstonCreditAmount"` // Winston credits granted for the transfer
+	DestinationAddress     string                `json:"destinationAddress"`
+	DestinationAddressType string                `json:"destinationAddressType"`
+	BlockHeight            int64                 `json:"blockHeight,omitempty"`
+}
+
+// FiatRates is the price of one GiB of uploads in winc and in each supported fiat currency
+type FiatRates struct {
+	WinC        Winc               `json:"winc"`
+	Fiat        map[string]float64 `json:"fiat"`
+	Adjustments []Adjustment       `json:"adjustments,omitempty"`
+	Fees        []Adjustment       `json:"fees,omitempty"`
+}
+
+// FiatToAR is the exchange rate between a fiat currency and AR
+type FiatToAR struct {
+	Currency string  `json:"currency"`
+	Rate     float64 `json:"rate"` // Price of one AR in the currency
+}
+
+// WincForFiat is the winc quoted for a fiat payment
+type WincForFiat struct {
+	WinC                Winc                `json:"winc"`
+	ActualPaymentAmount int64               `json:"actualPaymentAmount"` // Amount charged after adjustments, in the smallest currency unit
+	QuotedPaymentAmount int64               `json:"quotedPaymentAmount"` // Amount requested, in the smallest currency unit
+	Adjustments         []PaymentAdjustment `json:"adjustments,omitempty"`
+	Fees                []PaymentAdjustment `json:"fees,omitempty"`
+}
+
+// WincForToken is the winc quoted for a native token payment
+type WincForToken struct {
+	WinC                      Winc                `json:"winc"`
+	ActualTokenAmount         string              `json:"actualTokenAmount"`         // Amount in the token's base unit
+	EquivalentWincTokenAmount Winc                `json:"equivalentWincTokenAmount"` // Winc value of the amount before fees
+	Adjustments               []PaymentAdjustment `json:"adjustments,omitempty"`
+	Fees                      []PaymentAdjustment `json:"fees,omitempty"`
+}
+
+// CurrencyLimits are the payment bounds for a fiat currency, in its smallest unit
+type CurrencyLimits struct {
+	MinimumPaymentAmount    int64   `json:"minimumPaymentAmount"`
+	MaximumPaymentAmount    int64   `json:"maximumPaymentAmount"`
+	SuggestedPaymentAmounts []int64 `json:"suggestedPaymentAmounts"`
+	ZeroDecimalCurrency     bool    `json:"zeroDecimalCurrency"`
+}
+
+// SupportedCurrencies lists the fiat currencies accepted for top-ups and their limits
+type SupportedCurrencies struct {
+	SupportedCurrencies []string                  `json:"supportedCurrencies"`
+	Limits              map[string]CurrencyLimits `json:"limits"`
+}
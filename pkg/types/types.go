@@ -2,7 +2,9 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"io"
+	"time"
 )
 
 // TokenType represents the type of token/blockchain
@@ -11,37 +13,155 @@ type TokenType string
 const (
 	TokenTypeArweave  TokenType = "arweave"
 	TokenTypeEthereum TokenType = "ethereum"
+	TokenTypeSolana   TokenType = "solana"
+	TokenTypeKyve     TokenType = "kyve"
+	TokenTypeMatic    TokenType = "matic"
+	TokenTypePol      TokenType = "pol"
+	TokenTypeBaseEth  TokenType = "base-eth"
+	TokenTypeArio     TokenType = "ario"
 )
 
+// TokenTypes lists every token type accepted by Turbo
+var TokenTypes = []TokenType{
+	TokenTypeArweave,
+	TokenTypeEthereum,
+	TokenTypeSolana,
+	TokenTypeKyve,
+	TokenTypeMatic,
+	TokenTypePol,
+	TokenTypeBaseEth,
+	TokenTypeArio,
+}
+
+// IsValid reports whether the token type is accepted by Turbo
+func (t TokenType) IsValid() bool {
+	for _, token := range TokenTypes {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
 // Tag represents a key-value pair for metadata
 type Tag struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
 }
 
-// Balance represents a wallet's credit balance
+// Balance represents a wallet's credit balance as reported by the payment service
 type Balance struct {
-	WinC     string `json:"winc"`
-	Credits  string `json:"credits"`
-	Currency string `json:"currency"`
+	WinC              Winc                  `json:"winc"`             // Winc the wallet can spend, excluding credits shared with others
+	ControlledWinc    Winc                  `json:"controlledWinc"`   // Winc owned by the wallet, including credits shared with others
+	EffectiveBalance  Winc                  `json:"effectiveBalance"` // Winc the wallet can spend, including credits shared with it
+	GivenApprovals    []CreditShareApproval `json:"givenApprovals"`
+	ReceivedApprovals []CreditShareApproval `json:"receivedApprovals"`
+
+	// Credits and Currency are only set when reported by the service.
+	// Credits is encoded in JSON as a decimal amount of credits.
+	Credits  Winc   `json:"credits"`
+	Currency string `json:"currency,omitempty"`
+
+	// NoAccount is set when the payment service has no account for the address,
+	// in which case every amount is zero
+	NoAccount bool `json:"-"`
+}
+
+// balanceAlias has Balance's fields without its JSON methods
+type balanceAlias Balance
+
+// balanceJSON is the wire form of Balance, where credits are a decimal string
+type balanceJSON struct {
+	*balanceAlias
+	Credits string `json:"credits,omitempty"`
+}
+
+// MarshalJSON encodes Credits as a decimal amount of credits
+func (b Balance) MarshalJSON() ([]byte, error) {
+	wire := balanceJSON{balanceAlias: (*balanceAlias)(&b)}
+	if !b.Credits.IsZero() {
+		wire.Credits = b.Credits.Credits()
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON decodes Credits from a decimal amount of credits
+func (b *Balance) UnmarshalJSON(data []byte) error {
+	var decoded Balance
+	wire := balanceJSON{balanceAlias: (*balanceAlias)(&decoded)}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	if wire.Credits != "" {
+		credits, err := WincFromCredits(wire.Credits)
+		if err != nil {
+			return err
+		}
+		decoded.Credits = credits
+	}
+
+	*b = decoded
+	return nil
 }
 
+// CreditShareApproval is a delegation allowing ApprovedAddress to spend credits of PayingAddress
+type CreditShareApproval struct {
+	ApprovalDataItemID string `json:"approvalDataItemId"`
+	ApprovedAddress    string `json:"approvedAddress"`
+	PayingAddress      string `json:"payingAddress"`
+	ApprovedWincAmount Winc   `json:"approvedWincAmount"`
+	UsedWincAmount     Winc   `json:"usedWincAmount"`
+	CreationDate       string `json:"creationDate"`
+	ExpirationDate     string `json:"expirationDate,omitempty"`
+}
+
+// CreditShareApprovals lists the approvals a wallet has given to and received from others
+type CreditShareApprovals struct {
+	GivenApprovals    []CreditShareApproval `json:"givenApprovals"`
+	ReceivedApprovals []CreditShareApproval `json:"receivedApprovals"`
+}
+
+// ProgressStep identifies the stage of an upload
+type ProgressStep string
+
+const (
+	StepSigning    ProgressStep = "signing"
+	StepUploading  ProgressStep = "uploading"
+	StepFinalizing ProgressStep = "finalizing"
+)
+
 // ProgressEvent represents upload progress information
 type ProgressEvent struct {
-	TotalBytes     int64  `json:"totalBytes"`
-	ProcessedBytes int64  `json:"processedBytes"`
-	Step           string `json:"step"`
+	TotalBytes     int64        `json:"totalBytes"`
+	ProcessedBytes int64        `json:"processedBytes"`
+	Step           ProgressStep `json:"step"`
 }
 
 // ErrorEvent represents an error that occurred during upload
 type ErrorEvent struct {
-	Error error  `json:"error"`
-	Step  string `json:"step"`
+	Error error        `json:"error"`
+	Step  ProgressStep `json:"step"`
+}
+
+// RetryEvent describes a failed attempt that is about to be retried
+type RetryEvent struct {
+	Attempt    int           `json:"attempt"`
+	Delay      time.Duration `json:"delay"`
+	StatusCode int           `json:"statusCode,omitempty"`
+	Error      error         `json:"error,omitempty"`
+	Step       ProgressStep  `json:"step"`
 }
 
 // UploadEvents contains callback functions for upload events
 type UploadEvents struct {
-	OnProgress       func(ProgressEvent)
+	// OnProgress is called as bytes are signed and transferred. Events are throttled
+	// by ProgressByteInterval and ProgressTimeInterval; when both are zero an event is
+	// emitted for every read.
+	OnProgress           func(ProgressEvent)
+	ProgressByteInterval int64         // Minimum bytes between progress events
+	ProgressTimeInterval time.Duration // Minimum time between progress events
+
 	OnSigningStart   func()
 	OnSigningSuccess func()
 	OnSigningError   func(error)
@@ -49,6 +169,11 @@ type UploadEvents struct {
 	OnUploadStart    func()
 	OnUploadSuccess  func(*UploadResult)
 	OnUploadError    func(error)
+	OnRetry          func(RetryEvent)
+
+	// OnChunkedUploadStart receives the upload ID of a chunked upload session,
+	// which can be used to resume the upload after an interruption
+	OnChunkedUploadStart func(uploadID string)
 }
 
 // UploadRequest represents a request to upload data
@@ -60,6 +185,21 @@ type UploadRequest struct {
 	Anchor     string          `json:"anchor,omitempty"`
 	Events     *UploadEvents   `json:"-"`
 	Context    context.Context `json:"-"`
+
+	// DataStreamFactory and DataSizeFactory describe a re-openable payload that is
+	// streamed through signing and upload without being buffered. The factory must
+	// return the same bytes on every call.
+	DataStreamFactory func() (io.ReadCloser, error) `json:"-"`
+	DataSizeFactory   func() int64                  `json:"-"`
+
+	// PaidBy lists addresses that approved this wallet to spend their credits;
+	// the upload draws on those approvals before the wallet's own balance
+	PaidBy []string `json:"paidBy,omitempty"`
+
+	// MaxWinc caps the price of the signed data item. When set, or when the client has
+	// a default cap, the upload is priced and checked against the wallet's balance
+	// before any data is sent. It overrides the client's default.
+	MaxWinc *Winc `json:"maxWinc,omitempty"`
 }
 
 // UploadResult represents the result of an upload operation
@@ -72,13 +212,85 @@ type UploadResult struct {
 	Block               int64    `json:"block"`
 	ValidatorSet        []string `json:"validatorSet"`
 	Timestamp           int64    `json:"timestamp"`
+
+	// Set when the upload created or revoked credit share approvals
+	CreatedApproval  *CreditShareApproval  `json:"createdApproval,omitempty"`
+	RevokedApprovals []CreditShareApproval `json:"revokedApprovals,omitempty"`
+}
+
+// UploadStatusCode is the lifecycle state of an uploaded data item
+type UploadStatusCode string
+
+// Upload lifecycle states, in the order a data item moves through them
+const (
+	UploadStatusPending   UploadStatusCode = "PENDING"   // Accepted but not yet bundled
+	UploadStatusConfirmed UploadStatusCode = "CONFIRMED" // Bundled and posted to Arweave
+	UploadStatusFinalized UploadStatusCode = "FINALIZED" // Bundle is permanently settled on Arweave
+	UploadStatusFailed    UploadStatusCode = "FAILED"    // The service gave up on the data item
+)
+
+// UploadStatus describes the current state of an uploaded data item
+type UploadStatus struct {
+	ID           string           `json:"id"`
+	Status       UploadStatusCode `json:"status"`
+	BundleID     string           `json:"bundleId,omitempty"`
+	WinC         Winc             `json:"winc"`
+	Info         string           `json:"info,omitempty"`
+	FailedReason string           `json:"failedReason,omitempty"`
+	Timestamp    int64            `json:"timestamp,omitempty"`
+}
+
+// FreeUploadLimitBytes is the largest data item Turbo uploads free of charge
+const FreeUploadLimitBytes = 105 * 1024
+
+// IsFreeUpload reports whether a data item of byteCount bytes falls under the free-upload limit
+func IsFreeUpload(byteCount int64) bool {
+	return byteCount >= 0 && byteCount <= FreeUploadLimitBytes
+}
+
+// AdjustmentOperator describes how an adjustment modifies a price
+type AdjustmentOperator string
+
+const (
+	AdjustmentOperatorAdd      AdjustmentOperator = "add"      // OperatorMagnitude is added to the price
+	AdjustmentOperatorMultiply AdjustmentOperator = "multiply" // The price is multiplied by OperatorMagnitude
+	AdjustmentOperatorSubsidy  AdjustmentOperator = "subsidy"  // OperatorMagnitude of the price is subsidized
+)
+
+// Adjustment is a promotional discount, subsidy or fee applied to an upload price.
+// AdjustmentAmount is negative for discounts and subsidies.
+type Adjustment struct {
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	Operator          AdjustmentOperator `json:"operator"`
+	OperatorMagnitude float64            `json:"operatorMagnitude"`
+	AdjustmentAmount  Winc               `json:"adjustmentAmount"`
 }
 
 // UploadCost represents the cost estimate for uploading data
 type UploadCost struct {
-	Winc        string      `json:"winc"`
-	Bytes       int64       `json:"bytes"`
-	Adjustments interface{} `json:"adjustments,omitempty"`
+	Winc        Winc         `json:"winc"`
+	Bytes       int64        `json:"bytes"`
+	Adjustments []Adjustment `json:"adjustments,omitempty"`
+	Fees        []Adjustment `json:"fees,omitempty"`
+}
+
+// TotalAdjustments returns the sum of the adjustment amounts, excluding fees
+func (u UploadCost) TotalAdjustments() Winc {
+	var total Winc
+	for _, adjustment := range u.Adjustments {
+		total = total.Add(adjustment.AdjustmentAmount)
+	}
+	return total
+}
+
+// TotalFees returns the sum of the fee amounts
+func (u UploadCost) TotalFees() Winc {
+	var total Winc
+	for _, fee := range u.Fees {
+		total = total.Add(fee.AdjustmentAmount)
+	}
+	return total
 }
 
 // SignedDataItemUploadRequest represents a request to upload a pre-signed data item
@@ -87,4 +299,30 @@ type SignedDataItemUploadRequest struct {
 	DataItemSizeFactory   func() int64                  `json:"-"`
 	Events                *UploadEvents                 `json:"-"`
 	Context               context.Context               `json:"-"`
+	PaidBy                []string                      `json:"paidBy,omitempty"` // Addresses whose shared credits pay for the upload
+}
+
+// ChunkedUploadRequest represents a request to upload a pre-signed data item in chunks
+type ChunkedUploadRequest struct {
+	SignedDataItemUploadRequest
+
+	ChunkSize      int64         `json:"chunkSize,omitempty"`      // Bytes per chunk; zero uses the service default
+	MaxConcurrency int           `json:"maxConcurrency,omitempty"` // Chunks uploaded in parallel; zero uses the default
+	UploadID       string        `json:"uploadId,omitempty"`       // Existing upload session to resume
+	PollInterval   time.Duration `json:"-"`                        // Delay between finalization status checks
+}
+
+// ChunkedUploadInfo describes a chunked upload session
+type ChunkedUploadInfo struct {
+	ID        string     `json:"id"`
+	Min       int64      `json:"min"`
+	Max       int64      `json:"max"`
+	ChunkSize int64      `json:"chunkSize"`
+	Chunks    [][2]int64 `json:"chunks"` // Offset and size of each chunk received so far
+}
+
+// ChunkedUploadStatus describes the finalization state of a chunked upload session
+type ChunkedUploadStatus struct {
+	Status  string        `json:"status"`
+	Receipt *UploadResult `json:"receipt,omitempty"`
 }
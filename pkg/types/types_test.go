@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"strings"
 	"testing"
@@ -34,6 +35,22 @@ func TestTokenType(t *testing.T) {
 	}
 }
 
+func TestTokenTypeIsValid(t *testing.T) {
+	for _, token := range TokenTypes {
+		if !token.IsValid() {
+			t.Errorf("Expected %s to be valid", token)
+		}
+	}
+
+	if TokenType("dogecoin").IsValid() {
+		t.Error("Expected unknown token type to be invalid")
+	}
+
+	if TokenType("").IsValid() {
+		t.Error("Expected empty token type to be invalid")
+	}
+}
+
 func TestTag(t *testing.T) {
 	tag := Tag{
 		Name:  "Content-Type",
@@ -51,17 +68,17 @@ func TestTag(t *testing.T) {
 
 func TestBalance(t *testing.T) {
 	balance := Balance{
-		WinC:     "1000000000",
-		Credits:  "1.0",
+		WinC:     NewWinc(1000000000),
+		Credits:  NewWinc(WincPerCredit),
 		Currency: "USD",
 	}
 
-	if balance.WinC != "1000000000" {
+	if balance.WinC.String() != "1000000000" {
 		t.Errorf("Expected WinC '1000000000', got '%s'", balance.WinC)
 	}
 
-	if balance.Credits != "1.0" {
-		t.Errorf("Expected Credits '1.0', got '%s'", balance.Credits)
+	if balance.Credits.Credits() != "1" {
+		t.Errorf("Expected Credits '1', got '%s'", balance.Credits.Credits())
 	}
 
 	if balance.Currency != "USD" {
@@ -70,54 +87,81 @@ func TestBalance(t *testing.T) {
 }
 
 func TestUploadCost(t *testing.T) {
-	// Test with map adjustments (object)
-	costWithMap := UploadCost{
-		Winc:  "1000000",
+	cost := UploadCost{
+		Winc:  NewWinc(1000000),
 		Bytes: 1024,
-		Adjustments: map[string]interface{}{
-			"discount": 0.1,
+		Adjustments: []Adjustment{
+			{Name: "FWD Research July 2024 Subsidy", Operator: AdjustmentOperatorSubsidy, OperatorMagnitude: 0.6, AdjustmentAmount: NewWinc(-600000)},
+			{Name: "Promo", Operator: AdjustmentOperatorMultiply, OperatorMagnitude: 0.9, AdjustmentAmount: NewWinc(-40000)},
+		},
+		Fees: []Adjustment{
+			{Name: "Service fee", Operator: AdjustmentOperatorAdd, OperatorMagnitude: 500, AdjustmentAmount: NewWinc(500)},
 		},
 	}
 
-	if costWithMap.Winc != "1000000" {
-		t.Errorf("Expected Winc '1000000', got '%s'", costWithMap.Winc)
+	if cost.Winc.String() != "1000000" {
+		t.Errorf("Expected Winc '1000000', got '%s'", cost.Winc)
 	}
 
-	if costWithMap.Bytes != 1024 {
-		t.Errorf("Expected Bytes 1024, got %d", costWithMap.Bytes)
+	if cost.Bytes != 1024 {
+		t.Errorf("Expected Bytes 1024, got %d", cost.Bytes)
 	}
 
-	// Type assert to map to access the discount field
-	if adjustments, ok := costWithMap.Adjustments.(map[string]interface{}); ok {
-		if adjustments["discount"] != 0.1 {
-			t.Errorf("Expected discount 0.1, got %v", adjustments["discount"])
-		}
-	} else {
-		t.Errorf("Expected adjustments to be a map, got %T", costWithMap.Adjustments)
+	if total := cost.TotalAdjustments(); total.String() != "-640000" {
+		t.Errorf("Expected total adjustments -640000, got %s", total)
+	}
+
+	if total := cost.TotalFees(); total.String() != "500" {
+		t.Errorf("Expected total fees 500, got %s", total)
+	}
+
+	// A cost without adjustments or fees totals zero
+	if !(UploadCost{}).TotalAdjustments().IsZero() || !(UploadCost{}).TotalFees().IsZero() {
+		t.Error("Expected empty adjustments and fees to total zero")
 	}
+}
 
-	// Test with array adjustments (empty array like from API)
-	costWithArray := UploadCost{
-		Winc:        "2000000",
-		Bytes:       2048,
-		Adjustments: []interface{}{},
+func TestUploadCostJSON(t *testing.T) {
+	var cost UploadCost
+	err := json.Unmarshal([]byte(`{
+		"winc": "400000",
+		"adjustments": [{"name":"FWD Research July 2024 Subsidy","description":"A 60% discount for uploads over 500KiB","operator":"multiply","operatorMagnitude":0.4,"adjustmentAmount":"-600000"}],
+		"fees": [{"name":"Turbo fee","description":"","operator":"add","operatorMagnitude":100,"adjustmentAmount":100}]
+	}`), &cost)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
 	}
 
-	if costWithArray.Winc != "2000000" {
-		t.Errorf("Expected Winc '2000000', got '%s'", costWithArray.Winc)
+	if len(cost.Adjustments) != 1 {
+		t.Fatalf("Expected 1 adjustment, got %d", len(cost.Adjustments))
 	}
 
-	if costWithArray.Bytes != 2048 {
-		t.Errorf("Expected Bytes 2048, got %d", costWithArray.Bytes)
+	adjustment := cost.Adjustments[0]
+	if adjustment.Operator != AdjustmentOperatorMultiply || adjustment.OperatorMagnitude != 0.4 || adjustment.AdjustmentAmount.String() != "-600000" {
+		t.Errorf("Unexpected adjustment: %+v", adjustment)
+	}
+
+	if len(cost.Fees) != 1 || cost.Fees[0].AdjustmentAmount.String() != "100" {
+		t.Errorf("Unexpected fees: %+v", cost.Fees)
+	}
+}
+
+func TestIsFreeUpload(t *testing.T) {
+	testCases := []struct {
+		bytes    int64
+		expected bool
+	}{
+		{0, true},
+		{1024, true},
+		{FreeUploadLimitBytes, true},
+		{FreeUploadLimitBytes + 1, false},
+		{-1, false},
 	}
 
-	// Type assert to array to verify it's an empty array
-	if adjustments, ok := costWithArray.Adjustments.([]interface{}); ok {
-		if len(adjustments) != 0 {
-			t.Errorf("Expected empty adjustments array, got %v", adjustments)
+	for _, tc := range testCases {
+		if got := IsFreeUpload(tc.bytes); got != tc.expected {
+			t.Errorf("IsFreeUpload(%d) = %v, expected %v", tc.bytes, got, tc.expected)
 		}
-	} else {
-		t.Errorf("Expected adjustments to be an array, got %T", costWithArray.Adjustments)
 	}
 }
 
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// WincPerCredit is the number of winston credits in one credit, which is also the
+// number of winston in one AR
+const WincPerCredit = 1_000_000_000_000
+
+var wincPerCredit = big.NewInt(WincPerCredit)
+
+// Winc is an arbitrary-precision amount of Winston Credits. The zero value is zero winc.
+// Values are immutable: arithmetic returns a new Winc. Winc is encoded in JSON as a
+// decimal string, matching the Turbo services.
+type Winc struct {
+	value *big.Int
+}
+
+// NewWinc returns a Winc holding n winc
+func NewWinc(n int64) Winc {
+	return Winc{value: big.NewInt(n)}
+}
+
+// WincFromBigInt returns a Winc holding a copy of n
+func WincFromBigInt(n *big.Int) Winc {
+	if n == nil {
+		return Winc{}
+	}
+	return Winc{value: new(big.Int).Set(n)}
+}
+
+// ParseWinc parses a decimal integer amount of winc
+func ParseWinc(s string) (Winc, error) {
+	value, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok {
+		return Winc{}, fmt.Errorf("invalid winc amount %q", s)
+	}
+	return Winc{value: value}, nil
+}
+
+// WincFromCredits parses a decimal amount of credits (or AR) such as "1.5" into winc.
+// Amounts finer than one winc are rejected.
+func WincFromCredits(s string) (Winc, error) {
+	credits, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Winc{}, fmt.Errorf("invalid credit amount %q", s)
+	}
+
+	winc := credits.Mul(credits, new(big.Rat).SetInt(wincPerCredit))
+	if !winc.IsInt() {
+		return Winc{}, fmt.Errorf("credit amount %q is more precise than one winc", s)
+	}
+	return Winc{value: new(big.Int).Set(winc.Num())}, nil
+}
+
+// int returns the underlying value, treating the zero Winc as zero
+func (w Winc) int() *big.Int {
+	if w.value == nil {
+		return new(big.Int)
+	}
+	return w.value
+}
+
+// BigInt returns the amount as a new big.Int
+func (w Winc) BigInt() *big.Int {
+	return new(big.Int).Set(w.int())
+}
+
+// Add returns w + other
+func (w Winc) Add(other Winc) Winc {
+	return Winc{value: new(big.Int).Add(w.int(), other.int())}
+}
+
+// Sub returns w - other
+func (w Winc) Sub(other Winc) Winc {
+	return Winc{value: new(big.Int).Sub(w.int(), other.int())}
+}
+
+// Cmp compares w and other, returning -1, 0 or +1
+func (w Winc) Cmp(other Winc) int {
+	return w.int().Cmp(other.int())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of w
+func (w Winc) Sign() int {
+	return w.int().Sign()
+}
+
+// IsZero reports whether w is zero
+func (w Winc) IsZero() bool {
+	return w.Sign() == 0
+}
+
+// String returns the amount in winc as a decimal integer
+func (w Winc) String() string {
+	return w.int().String()
+}
+
+// Credits returns the exact amount in credits (or AR), without trailing zeros
+func (w Winc) Credits() string {
+	whole, frac := w.splitCredits()
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+// Format returns the amount in credits rounded half away from zero to the given number of
+// decimals, with comma-separated thousands (e.g. "1,234.57")
+func (w Winc) Format(decimals int) string {
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	// Round to the requested precision before splitting into whole and fractional parts
+	rounded := w.BigInt()
+	if decimals < 12 {
+		unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(12-decimals)), nil)
+		half := new(big.Int).Quo(unit, big.NewInt(2))
+		if rounded.Sign() < 0 {
+			rounded.Sub(rounded, half)
+		} else {
+			rounded.Add(rounded, half)
+		}
+		rounded.Quo(rounded, unit)
+		rounded.Mul(rounded, unit)
+	}
+
+	whole, frac := Winc{value: rounded}.splitCredits()
+	negative := strings.HasPrefix(whole, "-")
+	whole = groupThousands(strings.TrimPrefix(whole, "-"))
+	if negative {
+		whole = "-" + whole
+	}
+
+	if decimals == 0 {
+		return whole
+	}
+	frac += strings.Repeat("0", decimals)
+	return whole + "." + frac[:decimals]
+}
+
+// splitCredits returns the whole credits (with sign) and the fractional digits without trailing zeros
+func (w Winc) splitCredits() (string, string) {
+	abs := new(big.Int).Abs(w.int())
+	whole, frac := new(big.Int).QuoRem(abs, wincPerCredit, new(big.Int))
+
+	wholeStr := whole.String()
+	if w.Sign() < 0 {
+		wholeStr = "-" + wholeStr
+	}
+
+	fracStr := strings.TrimRight(fmt.Sprintf("%012s", frac.String()), "0")
+	return wholeStr, fracStr
+}
+
+// groupThousands inserts commas between groups of three digits
+func groupThousands(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// MarshalJSON encodes the amount as a decimal string
+func (w Winc) MarshalJSON() ([]byte, error) {
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON accepts the amount as a decimal string or a JSON number. Empty strings
+// and null decode to zero.
+func (w *Winc) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		*w = Winc{}
+		return nil
+	}
+
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		if text == "" {
+			*w = Winc{}
+			return nil
+		}
+	}
+
+	parsed, err := ParseWinc(text)
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseWinc(t *testing.T) {
+	winc, err := ParseWinc("123456789012345678901234567890")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if winc.String() != "123456789012345678901234567890" {
+		t.Errorf("Expected the full precision to be kept, got %s", winc)
+	}
+
+	for _, invalid := range []string{"", "1.5", "abc", "1e6"} {
+		if _, err := ParseWinc(invalid); err == nil {
+			t.Errorf("Expected error parsing %q", invalid)
+		}
+	}
+}
+
+func TestWincArithmetic(t *testing.T) {
+	a := NewWinc(1500)
+	b := NewWinc(500)
+
+	if sum := a.Add(b); sum.String() != "2000" {
+		t.Errorf("Expected 1500 + 500 = 2000, got %s", sum)
+	}
+	if diff := b.Sub(a); diff.String() != "-1000" || diff.Sign() != -1 {
+		t.Errorf("Expected 500 - 1500 = -1000, got %s", diff)
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(NewWinc(1500)) != 0 {
+		t.Error("Unexpected comparison results")
+	}
+
+	// Arithmetic never mutates its operands
+	if a.String() != "1500" || b.String() != "500" {
+		t.Errorf("Expected operands to be unchanged, got %s and %s", a, b)
+	}
+}
+
+func TestWincZeroValue(t *testing.T) {
+	var zero Winc
+
+	if !zero.IsZero() || zero.String() != "0" || zero.Credits() != "0" {
+		t.Errorf("Expected zero value to be 0, got %s", zero)
+	}
+	if sum := zero.Add(NewWinc(7)); sum.String() != "7" {
+		t.Errorf("Expected 0 + 7 = 7, got %s", sum)
+	}
+	if zero.BigInt().Cmp(big.NewInt(0)) != 0 {
+		t.Error("Expected BigInt of zero value to be 0")
+	}
+}
+
+func TestWincCredits(t *testing.T) {
+	testCases := []struct {
+		credits string
+		winc    string
+	}{
+		{"1", "1000000000000"},
+		{"1.5", "1500000000000"},
+		{"0.000000000001", "1"},
+		{"-2.25", "-2250000000000"},
+	}
+
+	for _, tc := range testCases {
+		winc, err := WincFromCredits(tc.credits)
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got %v", tc.credits, err)
+		}
+		if winc.String() != tc.winc {
+			t.Errorf("Expected %s credits to be %s winc, got %s", tc.credits, tc.winc, winc)
+		}
+		if winc.Credits() != tc.credits {
+			t.Errorf("Expected %s winc to be %s credits, got %s", tc.winc, tc.credits, winc.Credits())
+		}
+	}
+
+	if _, err := WincFromCredits("0.0000000000001"); err == nil {
+		t.Error("Expected error for an amount finer than one winc")
+	}
+}
+
+func TestWincFormat(t *testing.T) {
+	winc, _ := ParseWinc("1234567890000000")
+
+	testCases := []struct {
+		decimals int
+		expected string
+	}{
+		{0, "1,235"},
+		{2, "1,234.57"},
+		{4, "1,234.5679"},
+		{14, "1,234.56789000000000"},
+	}
+
+	for _, tc := range testCases {
+		if got := winc.Format(tc.decimals); got != tc.expected {
+			t.Errorf("Format(%d) = %s, expected %s", tc.decimals, got, tc.expected)
+		}
+	}
+
+	if got := NewWinc(-5_000_000_000).Format(2); got != "-0.01" {
+		t.Errorf("Expected negative amounts to round away from zero, got %s", got)
+	}
+}
+
+func TestWincJSON(t *testing.T) {
+	var cost UploadCost
+	if err := json.Unmarshal([]byte(`{"winc":"98765432109876543210","adjustments":[]}`), &cost); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cost.Winc.String() != "98765432109876543210" {
+		t.Errorf("Expected winc to be decoded, got %s", cost.Winc)
+	}
+
+	encoded, err := json.Marshal(cost.Winc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(encoded) != `"98765432109876543210"` {
+		t.Errorf("Expected winc to be encoded as a string, got %s", encoded)
+	}
+
+	// Numbers, empty strings and null are accepted as well
+	var winc Winc
+	for input, expected := range map[string]string{`42`: "42", `""`: "0", `null`: "0"} {
+		if err := json.Unmarshal([]byte(input), &winc); err != nil || winc.String() != expected {
+			t.Errorf("Unmarshal(%s) = %s, %v; expected %s", input, winc, err, expected)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`"not-a-number"`), &winc); err == nil {
+		t.Error("Expected error for an invalid amount")
+	}
+}
+
+func TestBalanceJSON(t *testing.T) {
+	var balance Balance
+	if err := json.Unmarshal([]byte(`{"winc":"1500000000000","credits":"1.5","currency":"USD"}`), &balance); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if balance.WinC.String() != "1500000000000" || balance.Credits.String() != "1500000000000" {
+		t.Errorf("Unexpected balance: winc %s, credits %s", balance.WinC, balance.Credits)
+	}
+
+	encoded, err := json.Marshal(balance)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(string(encoded), `"winc":"1500000000000"`) || !strings.Contains(string(encoded), `"credits":"1.5"`) {
+		t.Errorf("Unexpected encoding: %s", encoded)
+	}
+
+	var decoded Balance
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if decoded.WinC.Cmp(balance.WinC) != 0 || decoded.Credits.Cmp(balance.Credits) != 0 || decoded.Currency != "USD" {
+		t.Errorf("Expected balance to round-trip, got %+v", decoded)
+	}
+}